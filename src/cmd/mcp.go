@@ -33,6 +33,8 @@ func mcpServer(_ *cobra.Command, _ []string) {
 	go helpers.SetAutoConnectAfterBooting(appUsecase)
 	// Set auto reconnect checking
 	go helpers.SetAutoReconnectChecking(whatsappCli)
+	// Apply the declarative profile once connected
+	go helpers.SetDeclarativeProfile(userUsecase, config.WhatsappProfileFile)
 
 	// Create MCP server with capabilities
 	mcpServer := server.NewMCPServer(
@@ -71,6 +73,10 @@ func mcpServer(_ *cobra.Command, _ []string) {
 	newsletterHandler := mcp.InitMcpNewsletter(newsletterUsecase)
 	newsletterHandler.AddNewsletterTools(mcpServer)
 
+	// Template tools (save, list, preview)
+	templateHandler := mcp.InitMcpTemplate(templateUsecase)
+	templateHandler.AddTemplateTools(mcpServer)
+
 	// Get port from environment variable (Smithery sets this to 8081)
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -101,16 +107,17 @@ func mcpServer(_ *cobra.Command, _ []string) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		tools := `{
-			"total": 50,
+			"total": 97,
 			"note": "Complete API coverage with all advanced features implemented for MCP AI agents",
 			"categories": {
 				"app": ["whatsapp_get_qr", "whatsapp_login_with_code", "whatsapp_logout", "whatsapp_reconnect", "whatsapp_get_devices"],
-				"send": ["whatsapp_send_text", "whatsapp_send_image", "whatsapp_send_audio", "whatsapp_send_video", "whatsapp_send_file", "whatsapp_send_contact", "whatsapp_send_link", "whatsapp_send_location", "whatsapp_send_poll", "whatsapp_send_presence"],
-				"message": ["whatsapp_get_messages", "whatsapp_mark_as_read", "whatsapp_react_message", "whatsapp_delete_message", "whatsapp_update_message", "whatsapp_revoke_message", "whatsapp_star_message", "whatsapp_unstar_message", "whatsapp_download_media"],
-				"group": ["whatsapp_create_group", "whatsapp_leave_group", "whatsapp_get_group_info", "whatsapp_join_group_link", "whatsapp_get_invite_link", "whatsapp_set_group_name", "whatsapp_set_group_locked", "whatsapp_set_group_announce", "whatsapp_set_group_topic", "whatsapp_add_group_participants", "whatsapp_remove_group_participants", "whatsapp_promote_group_admin", "whatsapp_demote_group_admin", "whatsapp_get_group_info_from_link", "whatsapp_get_group_request_participants", "whatsapp_manage_group_request_participants"],
-				"user": ["whatsapp_get_user_info", "whatsapp_check_phone", "whatsapp_get_business_profile", "whatsapp_get_avatar", "whatsapp_change_avatar", "whatsapp_change_push_name", "whatsapp_get_my_groups", "whatsapp_get_my_newsletters", "whatsapp_get_my_contacts", "whatsapp_get_my_privacy"],
-				"chat": ["whatsapp_get_chat_list", "whatsapp_archive_chat", "whatsapp_mark_chat_as_read", "whatsapp_delete_chat"],
-				"newsletter": ["whatsapp_unfollow_newsletter"]
+				"send": ["whatsapp_send_text", "whatsapp_send_image", "whatsapp_send_audio", "whatsapp_send_video", "whatsapp_send_file", "whatsapp_send_contact", "whatsapp_send_group_invite", "whatsapp_send_link", "whatsapp_send_location", "whatsapp_send_poll", "whatsapp_send_template", "whatsapp_send_presence"],
+				"message": ["whatsapp_get_messages", "whatsapp_mark_as_read", "whatsapp_react_message", "whatsapp_delete_message", "whatsapp_update_message", "whatsapp_revoke_message", "whatsapp_star_message", "whatsapp_unstar_message", "whatsapp_pin_message", "whatsapp_unpin_message", "whatsapp_download_media", "whatsapp_get_poll_results", "whatsapp_get_message_receipts"],
+				"group": ["whatsapp_create_group", "whatsapp_leave_group", "whatsapp_get_group_info", "whatsapp_join_group_link", "whatsapp_accept_group_invite", "whatsapp_get_invite_link", "whatsapp_set_group_name", "whatsapp_set_group_locked", "whatsapp_set_group_announce", "whatsapp_set_group_topic", "whatsapp_set_group_join_approval", "whatsapp_set_group_member_add_mode", "whatsapp_set_group_ephemeral", "whatsapp_revoke_invite_link", "whatsapp_add_group_participants", "whatsapp_remove_group_participants", "whatsapp_promote_group_admin", "whatsapp_demote_group_admin", "whatsapp_bulk_add_group_participants", "whatsapp_get_group_info_from_link", "whatsapp_get_group_request_participants", "whatsapp_manage_group_request_participants", "whatsapp_link_community_group", "whatsapp_unlink_community_group", "whatsapp_get_community_subgroups", "whatsapp_get_community_participants", "whatsapp_send_community_announcement", "whatsapp_get_group_membership", "whatsapp_get_group_growth", "whatsapp_get_group_churn"],
+				"user": ["whatsapp_get_user_info", "whatsapp_check_phone", "whatsapp_bulk_check_phones", "whatsapp_get_business_profile", "whatsapp_get_avatar", "whatsapp_change_avatar", "whatsapp_change_push_name", "whatsapp_change_about", "whatsapp_get_my_groups", "whatsapp_get_my_newsletters", "whatsapp_get_my_contacts", "whatsapp_get_my_privacy", "whatsapp_update_my_privacy", "whatsapp_block_contact", "whatsapp_unblock_contact", "whatsapp_get_blocklist", "whatsapp_get_presence"],
+				"chat": ["whatsapp_get_chat_list", "whatsapp_get_pinned_messages", "whatsapp_archive_chat", "whatsapp_mark_chat_as_read", "whatsapp_delete_chat", "whatsapp_set_disappearing_timer", "whatsapp_set_default_disappearing_timer", "whatsapp_list_calls"],
+				"newsletter": ["whatsapp_unfollow_newsletter", "whatsapp_create_newsletter", "whatsapp_update_newsletter", "whatsapp_follow_newsletter", "whatsapp_mute_newsletter", "whatsapp_unmute_newsletter", "whatsapp_get_newsletter_info", "whatsapp_post_newsletter", "whatsapp_get_newsletter_messages"],
+				"template": ["whatsapp_save_template", "whatsapp_list_templates", "whatsapp_preview_template"]
 			}
 		}`
 		w.Write([]byte(tools))
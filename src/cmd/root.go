@@ -18,6 +18,7 @@ import (
 	domainMessage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/message"
 	domainNewsletter "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/newsletter"
 	domainSend "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/send"
+	domainTemplate "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/template"
 	domainUser "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/user"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/chatstorage"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
@@ -50,6 +51,7 @@ var (
 	messageUsecase    domainMessage.IMessageUsecase
 	groupUsecase      domainGroup.IGroupUsecase
 	newsletterUsecase domainNewsletter.INewsletterUsecase
+	templateUsecase   domainTemplate.ITemplateUsecase
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -110,6 +112,18 @@ func initEnvConfig() {
 	if viper.IsSet("whatsapp_auto_mark_read") {
 		config.WhatsappAutoMarkRead = viper.GetBool("whatsapp_auto_mark_read")
 	}
+	if viper.IsSet("whatsapp_drop_blocked_messages") {
+		config.WhatsappDropBlockedMessages = viper.GetBool("whatsapp_drop_blocked_messages")
+	}
+	if viper.IsSet("whatsapp_simulate_typing") {
+		config.WhatsappSimulateTyping = viper.GetBool("whatsapp_simulate_typing")
+	}
+	if envCallReject := viper.GetString("whatsapp_call_reject"); envCallReject != "" {
+		config.WhatsappCallRejectPolicy = envCallReject
+	}
+	if envCallRejectMessage := viper.GetString("whatsapp_call_reject_message"); envCallRejectMessage != "" {
+		config.WhatsappCallRejectMessage = envCallRejectMessage
+	}
 	if envWebhook := viper.GetString("whatsapp_webhook"); envWebhook != "" {
 		webhook := strings.Split(envWebhook, ",")
 		config.WhatsappWebhook = webhook
@@ -120,6 +134,21 @@ func initEnvConfig() {
 	if viper.IsSet("whatsapp_account_validation") {
 		config.WhatsappAccountValidation = viper.GetBool("whatsapp_account_validation")
 	}
+	if viper.IsSet("whatsapp_check_cache_ttl") {
+		config.WhatsappCheckCacheTTL = viper.GetDuration("whatsapp_check_cache_ttl")
+	}
+	if envDefaultCountry := viper.GetString("whatsapp_default_country"); envDefaultCountry != "" {
+		config.WhatsappDefaultCountry = envDefaultCountry
+	}
+	if viper.IsSet("whatsapp_link_preview_cache_ttl") {
+		config.WhatsappLinkPreviewCacheTTL = viper.GetDuration("whatsapp_link_preview_cache_ttl")
+	}
+	if viper.IsSet("whatsapp_newsletter_refresh_interval") {
+		config.WhatsappNewsletterRefreshInterval = viper.GetDuration("whatsapp_newsletter_refresh_interval")
+	}
+	if envProfileFile := viper.GetString("whatsapp_profile_file"); envProfileFile != "" {
+		config.WhatsappProfileFile = envProfileFile
+	}
 }
 
 func initFlags() {
@@ -183,6 +212,30 @@ func initFlags() {
 		config.WhatsappAutoMarkRead,
 		`auto mark incoming messages as read --auto-mark-read <true/false> | example: --auto-mark-read=true`,
 	)
+	rootCmd.PersistentFlags().BoolVarP(
+		&config.WhatsappDropBlockedMessages,
+		"drop-blocked-messages", "",
+		config.WhatsappDropBlockedMessages,
+		`only store messages from blocked contacts, without auto read, auto reply or webhooks --drop-blocked-messages <true/false> | example: --drop-blocked-messages=true`,
+	)
+	rootCmd.PersistentFlags().BoolVarP(
+		&config.WhatsappSimulateTyping,
+		"simulate-typing", "",
+		config.WhatsappSimulateTyping,
+		`mark the chat read and show typing before sending unless a request sets simulate_typing --simulate-typing <true/false> | example: --simulate-typing=true`,
+	)
+	rootCmd.PersistentFlags().StringVarP(
+		&config.WhatsappCallRejectPolicy,
+		"call-reject", "",
+		config.WhatsappCallRejectPolicy,
+		`reject incoming calls: off, always or outside_business_hours of the business profile --call-reject <string> | example: --call-reject=outside_business_hours`,
+	)
+	rootCmd.PersistentFlags().StringVarP(
+		&config.WhatsappCallRejectMessage,
+		"call-reject-message", "",
+		config.WhatsappCallRejectMessage,
+		`message sent to callers after rejecting their call --call-reject-message <string> | example: --call-reject-message="We can't take calls, please write us"`,
+	)
 	rootCmd.PersistentFlags().StringSliceVarP(
 		&config.WhatsappWebhook,
 		"webhook", "w",
@@ -201,6 +254,36 @@ func initFlags() {
 		config.WhatsappAccountValidation,
 		`enable or disable account validation --account-validation <true/false> | example: --account-validation=true`,
 	)
+	rootCmd.PersistentFlags().DurationVarP(
+		&config.WhatsappCheckCacheTTL,
+		"check-cache-ttl", "",
+		config.WhatsappCheckCacheTTL,
+		`how long WhatsApp registration checks are reused, 0 disables the cache --check-cache-ttl <duration> | example: --check-cache-ttl=12h`,
+	)
+	rootCmd.PersistentFlags().StringVarP(
+		&config.WhatsappDefaultCountry,
+		"default-country", "",
+		config.WhatsappDefaultCountry,
+		`country of phone numbers written without a country code --default-country <string> | example: --default-country=ID`,
+	)
+	rootCmd.PersistentFlags().DurationVarP(
+		&config.WhatsappLinkPreviewCacheTTL,
+		"link-preview-cache-ttl", "",
+		config.WhatsappLinkPreviewCacheTTL,
+		`how long fetched link preview metadata is reused --link-preview-cache-ttl <duration> | example: --link-preview-cache-ttl=6h`,
+	)
+	rootCmd.PersistentFlags().DurationVarP(
+		&config.WhatsappNewsletterRefreshInterval,
+		"newsletter-refresh-interval", "",
+		config.WhatsappNewsletterRefreshInterval,
+		`how often reaction and view counts of followed newsletters are refreshed, 0 disables it --newsletter-refresh-interval <duration> | example: --newsletter-refresh-interval=30m`,
+	)
+	rootCmd.PersistentFlags().StringVarP(
+		&config.WhatsappProfileFile,
+		"profile-file", "",
+		config.WhatsappProfileFile,
+		`apply a YAML or JSON profile (push name, about, privacy) after login --profile-file <string> | example: --profile-file=profile.yaml`,
+	)
 }
 
 func initChatStorage() (*sql.DB, error) {
@@ -233,6 +316,16 @@ func initApp() {
 		logrus.SetLevel(logrus.DebugLevel)
 	}
 
+	if config.WhatsappDefaultCountry != "" && !utils.IsSupportedCountry(config.WhatsappDefaultCountry) {
+		logrus.Fatalf("unsupported default country %q, use a two-letter country code such as ID or US", config.WhatsappDefaultCountry)
+	}
+
+	switch config.WhatsappCallRejectPolicy {
+	case whatsapp.CallRejectOff, whatsapp.CallRejectAlways, whatsapp.CallRejectOutsideBusinessHours:
+	default:
+		logrus.Fatalf("unsupported call reject policy %q, use off, always or outside_business_hours", config.WhatsappCallRejectPolicy)
+	}
+
 	//preparing folder if not exist
 	err := utils.CreateFolder(config.PathQrCode, config.PathSendItems, config.PathStorages, config.PathMedia)
 	if err != nil {
@@ -249,6 +342,7 @@ func initApp() {
 
 	chatStorageRepo = chatstorage.NewStorageRepository(chatStorageDB)
 	chatStorageRepo.InitializeSchema()
+	utils.SetWhatsAppCheckCache(chatStorageRepo)
 
 	whatsappDB := whatsapp.InitWaDB(ctx, config.DBURI)
 	var keysDB *sqlstore.Container
@@ -263,10 +357,11 @@ func initApp() {
 	appUsecase = usecase.NewAppService(chatStorageRepo)
 	chatUsecase = usecase.NewChatService(chatStorageRepo)
 	sendUsecase = usecase.NewSendService(appUsecase, chatStorageRepo)
-	userUsecase = usecase.NewUserService()
+	userUsecase = usecase.NewUserService(chatStorageRepo)
 	messageUsecase = usecase.NewMessageService(chatStorageRepo)
-	groupUsecase = usecase.NewGroupService()
-	newsletterUsecase = usecase.NewNewsletterService()
+	groupUsecase = usecase.NewGroupService(sendUsecase, chatStorageRepo)
+	newsletterUsecase = usecase.NewNewsletterService(sendUsecase, chatStorageRepo)
+	templateUsecase = usecase.NewTemplateService(chatStorageRepo)
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
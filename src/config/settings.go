@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"go.mau.fi/whatsmeow/proto/waCompanionReg"
 )
 
@@ -24,18 +26,28 @@ var (
 	DBURI     = "file:storages/whatsapp.db?_foreign_keys=on"
 	DBKeysURI = ""
 
-	WhatsappAutoReplyMessage       string
-	WhatsappAutoMarkRead           = false // Auto-mark incoming messages as read
-	WhatsappWebhook                []string
-	WhatsappWebhookSecret                = "secret"
-	WhatsappLogLevel                     = "ERROR"
-	WhatsappSettingMaxImageSize    int64 = 20000000  // 20MB
-	WhatsappSettingMaxFileSize     int64 = 50000000  // 50MB
-	WhatsappSettingMaxVideoSize    int64 = 100000000 // 100MB
-	WhatsappSettingMaxDownloadSize int64 = 500000000 // 500MB
-	WhatsappTypeUser                     = "@s.whatsapp.net"
-	WhatsappTypeGroup                    = "@g.us"
-	WhatsappAccountValidation            = true
+	WhatsappAutoReplyMessage          string
+	WhatsappAutoMarkRead              = false // Auto-mark incoming messages as read
+	WhatsappDropBlockedMessages       = false // Only store messages from blocked contacts, without auto read, auto reply or webhooks
+	WhatsappSimulateTyping            = false // Mark the chat read and show typing before every send unless the request overrides it
+	WhatsappCallRejectPolicy          = "off" // off, always or outside_business_hours
+	WhatsappCallRejectMessage         string  // Text sent to callers after their call is rejected automatically
+	WhatsappWebhook                   []string
+	WhatsappWebhookSecret                    = "secret"
+	WhatsappLogLevel                         = "ERROR"
+	WhatsappSettingMaxImageSize       int64  = 20000000  // 20MB
+	WhatsappSettingMaxFileSize        int64  = 50000000  // 50MB
+	WhatsappSettingMaxVideoSize       int64  = 100000000 // 100MB
+	WhatsappSettingMaxDownloadSize    int64  = 500000000 // 500MB
+	WhatsappSettingMaxPreviewSize     int64  = 5000000   // 5MB, applies to fetched pages and preview images
+	WhatsappLinkPreviewCacheTTL              = 24 * time.Hour
+	WhatsappNewsletterRefreshInterval        = 15 * time.Minute // 0 disables the periodic newsletter count refresh
+	WhatsappTypeUser                         = "@s.whatsapp.net"
+	WhatsappTypeGroup                        = "@g.us"
+	WhatsappAccountValidation                = true
+	WhatsappCheckCacheTTL                    = 24 * time.Hour // How long WhatsApp registration checks are reused, 0 disables the cache
+	WhatsappDefaultCountry            string                  // Two-letter country code used for phone numbers written without a country code
+	WhatsappProfileFile               string                  // YAML or JSON profile applied once the account is logged in
 
 	ChatStorageURI               = "file:storages/chatstorage.db"
 	ChatStorageEnableForeignKeys = true
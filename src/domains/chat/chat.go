@@ -63,18 +63,29 @@ type ChatInfo struct {
 }
 
 type MessageInfo struct {
-	ID         string `json:"id"`
-	ChatJID    string `json:"chat_jid"`
-	SenderJID  string `json:"sender_jid"`
-	Content    string `json:"content"`
-	Timestamp  string `json:"timestamp"`
-	IsFromMe   bool   `json:"is_from_me"`
-	MediaType  string `json:"media_type"`
-	Filename   string `json:"filename"`
-	URL        string `json:"url"`
-	FileLength uint64 `json:"file_length"`
-	CreatedAt  string `json:"created_at"`
-	UpdatedAt  string `json:"updated_at"`
+	ID         string         `json:"id"`
+	ChatJID    string         `json:"chat_jid"`
+	SenderJID  string         `json:"sender_jid"`
+	Content    string         `json:"content"`
+	Timestamp  string         `json:"timestamp"`
+	IsFromMe   bool           `json:"is_from_me"`
+	MediaType  string         `json:"media_type"`
+	Filename   string         `json:"filename"`
+	URL        string         `json:"url"`
+	FileLength uint64         `json:"file_length"`
+	IsEdited   bool           `json:"is_edited"`
+	EditedAt   string         `json:"edited_at,omitempty"`
+	Status     string         `json:"status,omitempty"` // sent, delivered, read or played, only for messages sent by this account
+	Reactions  []ReactionInfo `json:"reactions,omitempty"`
+	CreatedAt  string         `json:"created_at"`
+	UpdatedAt  string         `json:"updated_at"`
+}
+
+// ReactionInfo groups everyone who reacted to a message with the same emoji
+type ReactionInfo struct {
+	Emoji    string   `json:"emoji"`
+	Count    int      `json:"count"`
+	Reactors []string `json:"reactors"`
 }
 
 type PaginationResponse struct {
@@ -118,3 +129,66 @@ type MarkChatAsReadResponse struct {
 	Message string `json:"message"`
 	ChatJID string `json:"chat_jid"`
 }
+
+// Disappearing timer operations
+type SetDisappearingTimerRequest struct {
+	ChatJID string `json:"chat_jid" uri:"chat_jid"`
+	Timer   string `json:"timer"` // off, 24h, 7d or 90d
+}
+
+type SetDefaultDisappearingTimerRequest struct {
+	Timer string `json:"timer"` // off, 24h, 7d or 90d
+}
+
+type SetDisappearingTimerResponse struct {
+	Status              string `json:"status"`
+	Message             string `json:"message"`
+	ChatJID             string `json:"chat_jid,omitempty"`
+	Timer               string `json:"timer"`
+	EphemeralExpiration uint32 `json:"ephemeral_expiration"`
+}
+
+// Pinned message operations
+type GetPinnedMessagesRequest struct {
+	ChatJID string `json:"chat_jid" uri:"chat_jid"`
+}
+
+type GetPinnedMessagesResponse struct {
+	ChatJID string              `json:"chat_jid"`
+	Data    []PinnedMessageInfo `json:"data"`
+}
+
+type PinnedMessageInfo struct {
+	MessageID string `json:"message_id"`
+	SenderJID string `json:"sender_jid,omitempty"`
+	Content   string `json:"content,omitempty"`
+	MediaType string `json:"media_type,omitempty"`
+	PinnedBy  string `json:"pinned_by"`
+	PinnedAt  string `json:"pinned_at"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// Call log operations
+type ListCallsRequest struct {
+	Phone  string `json:"phone" query:"phone"`
+	Limit  int    `json:"limit" query:"limit"`
+	Offset int    `json:"offset" query:"offset"`
+}
+
+type ListCallsResponse struct {
+	Data       []CallInfo         `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type CallInfo struct {
+	CallID       string `json:"call_id"`
+	From         string `json:"from"`
+	GroupJID     string `json:"group_jid,omitempty"`
+	IsGroup      bool   `json:"is_group"`
+	IsVideo      bool   `json:"is_video"`
+	Status       string `json:"status"`
+	AutoRejected bool   `json:"auto_rejected"`
+	Reason       string `json:"reason,omitempty"`
+	StartedAt    string `json:"started_at"`
+	EndedAt      string `json:"ended_at,omitempty"`
+}
@@ -12,4 +12,8 @@ type IChatUsecase interface {
 	ArchiveChat(ctx context.Context, request ArchiveChatRequest) (response ArchiveChatResponse, err error)
 	DeleteChat(ctx context.Context, request DeleteChatRequest) (response DeleteChatResponse, err error)
 	MarkChatAsRead(ctx context.Context, request MarkChatAsReadRequest) (response MarkChatAsReadResponse, err error)
+	SetDisappearingTimer(ctx context.Context, request SetDisappearingTimerRequest) (response SetDisappearingTimerResponse, err error)
+	SetDefaultDisappearingTimer(ctx context.Context, request SetDefaultDisappearingTimerRequest) (response SetDisappearingTimerResponse, err error)
+	GetPinnedMessages(ctx context.Context, request GetPinnedMessagesRequest) (response GetPinnedMessagesResponse, err error)
+	ListCalls(ctx context.Context, request ListCallsRequest) (response ListCallsResponse, err error)
 }
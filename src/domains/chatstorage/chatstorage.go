@@ -14,21 +14,32 @@ type Chat struct {
 
 // Message represents a WhatsApp message
 type Message struct {
-	ID            string    `db:"id"`
-	ChatJID       string    `db:"chat_jid"`
-	Sender        string    `db:"sender"`
-	Content       string    `db:"content"`
-	Timestamp     time.Time `db:"timestamp"`
-	IsFromMe      bool      `db:"is_from_me"`
-	MediaType     string    `db:"media_type"`
-	Filename      string    `db:"filename"`
-	URL           string    `db:"url"`
-	MediaKey      []byte    `db:"media_key"`
-	FileSHA256    []byte    `db:"file_sha256"`
-	FileEncSHA256 []byte    `db:"file_enc_sha256"`
-	FileLength    uint64    `db:"file_length"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
+	ID            string     `db:"id"`
+	ChatJID       string     `db:"chat_jid"`
+	Sender        string     `db:"sender"`
+	Content       string     `db:"content"`
+	Timestamp     time.Time  `db:"timestamp"`
+	IsFromMe      bool       `db:"is_from_me"`
+	MediaType     string     `db:"media_type"`
+	Filename      string     `db:"filename"`
+	URL           string     `db:"url"`
+	MediaKey      []byte     `db:"media_key"`
+	FileSHA256    []byte     `db:"file_sha256"`
+	FileEncSHA256 []byte     `db:"file_enc_sha256"`
+	FileLength    uint64     `db:"file_length"`
+	EditedAt      *time.Time `db:"edited_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// MessageEdit represents one entry in the edit history of a message
+type MessageEdit struct {
+	MessageID       string    `db:"message_id"`
+	ChatJID         string    `db:"chat_jid"`
+	Editor          string    `db:"editor"`
+	PreviousContent string    `db:"previous_content"`
+	NewContent      string    `db:"new_content"`
+	EditedAt        time.Time `db:"edited_at"`
 }
 
 // MediaInfo represents downloadable media information
@@ -44,6 +55,167 @@ type MediaInfo struct {
 	FileLength    uint64
 }
 
+// LinkPreview represents cached metadata of a URL used to build rich link previews
+type LinkPreview struct {
+	URL         string    `db:"url"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	ImageURL    string    `db:"image_url"`
+	Image       []byte    `db:"image"`
+	Width       uint32    `db:"width"`
+	Height      uint32    `db:"height"`
+	FetchedAt   time.Time `db:"fetched_at"`
+}
+
+// WhatsAppCheck represents a cached answer of whether a phone number is registered on WhatsApp
+type WhatsAppCheck struct {
+	Phone        string    `db:"phone"`
+	JID          string    `db:"jid"`
+	IsOnWhatsApp bool      `db:"is_on_whatsapp"`
+	IsBusiness   bool      `db:"is_business"`
+	VerifiedName string    `db:"verified_name"`
+	CheckedAt    time.Time `db:"checked_at"`
+}
+
+// Delivery statuses of an outgoing message, in the order a recipient reaches them
+const (
+	MessageStatusSent      = "sent"
+	MessageStatusDelivered = "delivered"
+	MessageStatusRead      = "read"
+	MessageStatusPlayed    = "played"
+)
+
+// MessageReceipt represents how far an outgoing message got with one recipient, in groups every participant
+// has their own receipt. Each time is when that status was first reported.
+type MessageReceipt struct {
+	MessageID   string     `db:"message_id"`
+	ChatJID     string     `db:"chat_jid"`
+	Participant string     `db:"participant"`
+	DeliveredAt *time.Time `db:"delivered_at"`
+	ReadAt      *time.Time `db:"read_at"`
+	PlayedAt    *time.Time `db:"played_at"`
+}
+
+// MessageReaction represents the current reaction of one person to a message
+type MessageReaction struct {
+	MessageID string    `db:"message_id"`
+	ChatJID   string    `db:"chat_jid"`
+	Reactor   string    `db:"reactor"`
+	Emoji     string    `db:"emoji"`
+	ReactedAt time.Time `db:"reacted_at"`
+}
+
+// Presence represents the last known online state and typing state of a contact
+type Presence struct {
+	JID           string     `db:"jid"`
+	Subscribed    bool       `db:"subscribed"`
+	IsOnline      bool       `db:"is_online"`
+	LastSeen      *time.Time `db:"last_seen"`
+	ChatState     string     `db:"chat_state"`      // composing, recording or paused
+	ChatStateChat string     `db:"chat_state_chat"` // Chat the contact is typing in
+	ChatStateAt   *time.Time `db:"chat_state_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// Call statuses stored in the call log
+const (
+	CallStatusRinging  = "ringing"
+	CallStatusAccepted = "accepted"
+	CallStatusRejected = "rejected"
+	CallStatusMissed   = "missed"
+	CallStatusEnded    = "ended"
+)
+
+// Call represents an incoming call in the call log
+type Call struct {
+	ID           string     `db:"id"`
+	Caller       string     `db:"caller"`
+	GroupJID     string     `db:"group_jid"`
+	IsGroup      bool       `db:"is_group"`
+	IsVideo      bool       `db:"is_video"`
+	Status       string     `db:"status"` // ringing, accepted, rejected, missed or ended
+	AutoRejected bool       `db:"auto_rejected"`
+	Reason       string     `db:"reason"` // Termination reason reported by WhatsApp
+	StartedAt    time.Time  `db:"started_at"`
+	EndedAt      *time.Time `db:"ended_at"`
+}
+
+// Poll represents a poll message together with the secret needed to decrypt its votes
+type Poll struct {
+	MessageID string    `db:"message_id"`
+	ChatJID   string    `db:"chat_jid"`
+	Creator   string    `db:"creator"`
+	Question  string    `db:"question"`
+	Options   []string  `db:"options"`
+	MaxAnswer int       `db:"max_answer"`
+	Secret    []byte    `db:"secret"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// PollVote represents the latest vote of a single voter on a poll
+type PollVote struct {
+	PollMessageID   string    `db:"poll_message_id"`
+	Voter           string    `db:"voter"`
+	SelectedOptions []string  `db:"selected_options"`
+	VoteMessageID   string    `db:"vote_message_id"`
+	VotedAt         time.Time `db:"voted_at"`
+}
+
+// PinnedMessage represents a message pinned inside a chat until it expires or is unpinned
+type PinnedMessage struct {
+	MessageID string    `db:"message_id"`
+	ChatJID   string    `db:"chat_jid"`
+	PinnedBy  string    `db:"pinned_by"`
+	PinnedAt  time.Time `db:"pinned_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// NewsletterMessage represents a post of a newsletter (channel) identified by its server ID
+type NewsletterMessage struct {
+	NewsletterJID  string         `db:"newsletter_jid"`
+	ServerID       int64          `db:"server_id"`
+	MessageID      string         `db:"message_id"`
+	Type           string         `db:"type"`
+	Content        string         `db:"content"`
+	MediaType      string         `db:"media_type"`
+	Timestamp      time.Time      `db:"timestamp"`
+	ViewsCount     int            `db:"views_count"`
+	ReactionCounts map[string]int `db:"reaction_counts"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+// Group membership actions recorded in the group membership history
+const (
+	GroupMembershipSnapshot = "snapshot"
+	GroupMembershipJoin     = "join"
+	GroupMembershipAdd      = "add"
+	GroupMembershipLeave    = "leave"
+	GroupMembershipRemove   = "remove"
+	GroupMembershipPromote  = "promote"
+	GroupMembershipDemote   = "demote"
+)
+
+// GroupMembershipEvent records a participant change of a group, or a participant seen in a full snapshot
+type GroupMembershipEvent struct {
+	ID             int64     `db:"id"`
+	GroupJID       string    `db:"group_jid"`
+	ParticipantJID string    `db:"participant_jid"`
+	Action         string    `db:"action"`
+	ActorJID       string    `db:"actor_jid"`
+	IsAdmin        bool      `db:"is_admin"` // only meaningful for snapshot rows
+	Timestamp      time.Time `db:"timestamp"`
+}
+
+// MessageTemplate represents one version of a named server-side message template
+type MessageTemplate struct {
+	Name      string    `db:"name"`
+	Version   int       `db:"version"`
+	Body      string    `db:"body"`
+	MediaType string    `db:"media_type"`
+	MediaURL  string    `db:"media_url"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
 // MessageFilter represents query filters for messages
 type MessageFilter struct {
 	ChatJID   string
@@ -55,6 +227,13 @@ type MessageFilter struct {
 	IsFromMe  *bool
 }
 
+// CallFilter represents query filters for the call log
+type CallFilter struct {
+	Caller string
+	Limit  int
+	Offset int
+}
+
 // ChatFilter represents query filters for chats
 type ChatFilter struct {
 	Limit      int
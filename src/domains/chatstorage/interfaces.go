@@ -13,6 +13,10 @@ type IChatStorageRepository interface {
 	CreateMessage(ctx context.Context, evt *events.Message) error
 	StoreChat(chat *Chat) error
 	GetChat(jid string) (*Chat, error)
+	UpdateChatEphemeralExpiration(jid string, expiration uint32) error
+	UpdateChatName(jid string, name string) error
+	UpdateChatLastReadTime(jid string, readAt time.Time) error
+	GetChatLastReadTime(jid string) (time.Time, error)
 	GetChats(filter *ChatFilter) ([]*Chat, error)
 	DeleteChat(jid string) error
 	DeleteChatAndMessages(jid string) error
@@ -24,7 +28,75 @@ type IChatStorageRepository interface {
 	GetMessages(filter *MessageFilter) ([]*Message, error)
 	SearchMessages(chatJID, searchText string, limit int) ([]*Message, error) // Database-level search
 	DeleteMessage(id, chatJID string) error
-	StoreSentMessageWithContext(ctx context.Context, messageID string, senderJID string, recipientJID string, content string, timestamp time.Time) error
+	StoreSentMessageWithContext(ctx context.Context, messageID string, senderJID string, recipientJID string, content string, mediaType string, timestamp time.Time) error
+
+	// Message edit operations
+	StoreMessageEdit(edit *MessageEdit) error
+	GetMessageEdits(messageID, chatJID string) ([]*MessageEdit, error)
+
+	// Message receipts
+	StoreMessageReceipts(chatJID, participant, status string, messageIDs []string, timestamp time.Time) error
+	GetMessageReceipts(chatJID string, messageIDs ...string) ([]*MessageReceipt, error)
+
+	// Message reactions
+	StoreMessageReaction(reaction *MessageReaction) error
+	DeleteMessageReaction(messageID, chatJID, reactor string, removedAt time.Time) error
+	GetMessageReactions(chatJID string, messageIDs ...string) ([]*MessageReaction, error)
+
+	// Pinned message operations
+	StorePinnedMessage(pin *PinnedMessage) error
+	DeletePinnedMessage(messageID, chatJID string) error
+	GetPinnedMessages(chatJID string) ([]*PinnedMessage, error)
+
+	// Newsletter post operations
+	StoreNewsletterMessage(message *NewsletterMessage) error
+	UpdateNewsletterMessageCounts(newsletterJID string, serverID int64, viewsCount int, reactionCounts map[string]int) error
+	GetNewsletterMessages(newsletterJID string, limit int, beforeServerID int64) ([]*NewsletterMessage, error)
+
+	// Group membership history
+	StoreGroupMembershipEvents(events []*GroupMembershipEvent) error
+	HasGroupMembershipEvents(groupJID string) (bool, error)
+	GetGroupMembershipEvents(groupJID string, until time.Time) ([]*GroupMembershipEvent, error)
+	GetGroupMembershipGroups() ([]string, error)
+
+	// Poll operations
+	StorePoll(poll *Poll) error
+	GetPoll(messageID string) (*Poll, error)
+	StorePollVote(vote *PollVote) error
+	GetPollVotes(pollMessageID string) ([]*PollVote, error)
+
+	// Message template operations
+	StoreTemplate(template *MessageTemplate) error
+	GetTemplate(name string, version int) (*MessageTemplate, error)
+	GetTemplates() ([]*MessageTemplate, error)
+	GetTemplateVersions(name string) ([]*MessageTemplate, error)
+	DeleteTemplate(name string) error
+
+	// Link preview cache
+	GetLinkPreview(url string) (*LinkPreview, error)
+	StoreLinkPreview(preview *LinkPreview) error
+
+	// WhatsApp registration check cache
+	GetWhatsAppChecks(phones []string, checkedAfter time.Time) (map[string]*WhatsAppCheck, error)
+	StoreWhatsAppChecks(checks []*WhatsAppCheck) error
+
+	// Contact presence
+	SetPresenceSubscribed(jid string, subscribed bool) error
+	GetPresenceSubscriptions() ([]string, error)
+	StorePresence(jid string, online bool, lastSeen time.Time) error
+	StoreChatState(jid, chatJID, state string) error
+	GetPresence(jid string) (*Presence, error)
+
+	// Call log
+	StoreCall(call *Call) error
+	GetCall(callID string) (*Call, error)
+	UpdateCallStatus(callID, status, reason string, endedAt *time.Time) error
+	GetCalls(filter *CallFilter) ([]*Call, error)
+	CountCalls(filter *CallFilter) (int64, error)
+
+	// Account settings
+	StoreDefaultEphemeralExpiration(expiration uint32) error
+	GetDefaultEphemeralExpiration() (uint32, error)
 
 	// Statistics
 	GetChatMessageCount(chatJID string) (int64, error)
@@ -13,6 +13,13 @@ type JoinGroupWithLinkRequest struct {
 	Link string `json:"link" form:"link"`
 }
 
+type AcceptGroupInviteRequest struct {
+	GroupID          string `json:"group_id" form:"group_id"`
+	Inviter          string `json:"inviter" form:"inviter"`
+	InviteCode       string `json:"invite_code" form:"invite_code"`
+	InviteExpiration int64  `json:"invite_expiration" form:"invite_expiration"` // unix seconds, as received in the invite message, 0 for a shared invite link
+}
+
 type LeaveGroupRequest struct {
 	GroupID string `json:"group_id" form:"group_id"`
 }
@@ -20,6 +27,8 @@ type LeaveGroupRequest struct {
 type CreateGroupRequest struct {
 	Title        string   `json:"title" form:"title"`
 	Participants []string `json:"participants" form:"participants"`
+	IsCommunity  bool     `json:"is_community" form:"is_community"` // create a community (parent group) instead of a group
+	CommunityID  string   `json:"community_id" form:"community_id"` // create the group inside this community
 }
 
 type ParticipantRequest struct {
@@ -99,6 +108,25 @@ type GroupInfoRequest struct {
 	GroupID string `json:"group_id" query:"group_id"`
 }
 
+type SetGroupJoinApprovalRequest struct {
+	GroupID         string `json:"group_id" form:"group_id"`
+	RequireApproval bool   `json:"require_approval" form:"require_approval"`
+}
+
+type SetGroupMemberAddModeRequest struct {
+	GroupID string `json:"group_id" form:"group_id"`
+	Mode    string `json:"mode" form:"mode"` // admin_add or all_member_add
+}
+
+type SetGroupEphemeralRequest struct {
+	GroupID string `json:"group_id" form:"group_id"`
+	Timer   string `json:"timer" form:"timer"` // off, 24h, 7d or 90d
+}
+
+type RevokeGroupInviteLinkRequest struct {
+	GroupID string `json:"group_id" form:"group_id"`
+}
+
 type GetGroupInviteLinkRequest struct {
 	GroupID string `json:"group_id" query:"group_id"`
 	Reset   bool   `json:"reset" query:"reset"`
@@ -110,5 +138,123 @@ type GetGroupInviteLinkResponse struct {
 }
 
 type GroupInfoResponse struct {
-	Data any `json:"data"`
+	Data              any    `json:"data"`
+	IsParent          bool   `json:"is_parent"`
+	LinkedParentJID   string `json:"linked_parent_jid,omitempty"`
+	IsDefaultSubGroup bool   `json:"is_default_sub_group"`
+}
+
+type LinkCommunityGroupRequest struct {
+	CommunityID string `json:"community_id" form:"community_id"`
+	GroupID     string `json:"group_id" form:"group_id"`
+}
+
+type CommunityRequest struct {
+	CommunityID string `json:"community_id" query:"community_id"`
+}
+
+type CommunitySubGroup struct {
+	JID               string `json:"jid"`
+	Name              string `json:"name"`
+	IsDefaultSubGroup bool   `json:"is_default_sub_group"` // the announcement group of the community
+}
+
+type CommunityParticipantsResponse struct {
+	CommunityID  string   `json:"community_id"`
+	Participants []string `json:"participants"`
+}
+
+type CommunityAnnouncementRequest struct {
+	CommunityID string `json:"community_id" form:"community_id"`
+	Message     string `json:"message" form:"message"`
+}
+
+type CommunityAnnouncementResponse struct {
+	GroupID   string `json:"group_id"`
+	MessageID string `json:"message_id"`
+	Status    string `json:"status"`
+}
+
+type GroupMembershipRequest struct {
+	GroupID string `json:"group_id" query:"group_id"`
+	At      string `json:"at" query:"at"` // RFC3339, defaults to now
+}
+
+type GroupMember struct {
+	JID     string `json:"jid"`
+	IsAdmin bool   `json:"is_admin"`
+	Since   string `json:"since"`              // when the participant joined, or was first seen in a snapshot
+	Source  string `json:"source"`             // join, add or snapshot
+	AddedBy string `json:"added_by,omitempty"` // the admin who added the participant
+}
+
+type GroupMembershipResponse struct {
+	GroupID      string        `json:"group_id"`
+	At           string        `json:"at"`
+	TrackedSince string        `json:"tracked_since"` // membership before this time is unknown
+	Total        int           `json:"total"`
+	Members      []GroupMember `json:"members"`
+}
+
+type GroupMembershipPeriodRequest struct {
+	GroupID string `json:"group_id" query:"group_id"`
+	From    string `json:"from" query:"from"` // RFC3339, defaults to 30 days before to
+	To      string `json:"to" query:"to"`     // RFC3339, defaults to now
+}
+
+type GroupGrowthPoint struct {
+	Date    string `json:"date"`
+	Joined  int    `json:"joined"`
+	Left    int    `json:"left"`
+	Members int    `json:"members"`
+}
+
+type GroupGrowthResponse struct {
+	GroupID        string             `json:"group_id"`
+	From           string             `json:"from"`
+	To             string             `json:"to"`
+	MembersAtStart int                `json:"members_at_start"`
+	MembersAtEnd   int                `json:"members_at_end"`
+	Joined         int                `json:"joined"`
+	Left           int                `json:"left"`
+	NetGrowth      int                `json:"net_growth"`
+	Daily          []GroupGrowthPoint `json:"daily"`
+}
+
+type GroupChurn struct {
+	GroupID        string  `json:"group_id"`
+	MembersAtStart int     `json:"members_at_start"`
+	Joined         int     `json:"joined"`
+	Left           int     `json:"left"`
+	ChurnRate      float64 `json:"churn_rate"` // left / members_at_start
+}
+
+type GroupChurnResponse struct {
+	From string       `json:"from"`
+	To   string       `json:"to"`
+	Data []GroupChurn `json:"data"`
+}
+
+type BulkAddParticipantsRequest struct {
+	GroupID       string                `json:"group_id" form:"group_id"`
+	File          *multipart.FileHeader `json:"file" form:"file"` // CSV with phone numbers in the first column
+	Participants  []string              `json:"participants" form:"participants"`
+	InviteMessage string                `json:"invite_message" form:"invite_message"` // caption of the invite sent when a participant cannot be added
+	BatchSize     int                   `json:"batch_size" form:"batch_size"`
+	BatchDelay    int                   `json:"batch_delay" form:"batch_delay"` // seconds to wait between batches
+}
+
+type BulkParticipantResult struct {
+	Participant string `json:"participant"`
+	Status      string `json:"status"` // added, invited or failed
+	Reason      string `json:"reason,omitempty"`
+}
+
+type BulkAddParticipantsResponse struct {
+	GroupID string                  `json:"group_id"`
+	Total   int                     `json:"total"`
+	Added   int                     `json:"added"`
+	Invited int                     `json:"invited"`
+	Failed  int                     `json:"failed"`
+	Results []BulkParticipantResult `json:"results"`
 }
@@ -7,6 +7,7 @@ import (
 // IGroupManagement handles basic group management operations
 type IGroupManagement interface {
 	JoinGroupWithLink(ctx context.Context, request JoinGroupWithLinkRequest) (groupID string, err error)
+	AcceptGroupInvite(ctx context.Context, request AcceptGroupInviteRequest) (groupID string, err error)
 	LeaveGroup(ctx context.Context, request LeaveGroupRequest) (err error)
 	CreateGroup(ctx context.Context, request CreateGroupRequest) (groupID string, err error)
 	GetGroupInfoFromLink(ctx context.Context, request GetGroupInfoFromLinkRequest) (response GetGroupInfoFromLinkResponse, err error)
@@ -19,6 +20,7 @@ type IGroupParticipants interface {
 	ManageParticipant(ctx context.Context, request ParticipantRequest) (result []ParticipantStatus, err error)
 	GetGroupRequestParticipants(ctx context.Context, request GetGroupRequestParticipantsRequest) (result []GetGroupRequestParticipantsResponse, err error)
 	ManageGroupRequestParticipants(ctx context.Context, request GroupRequestParticipantsRequest) (result []ParticipantStatus, err error)
+	BulkAddParticipants(ctx context.Context, request BulkAddParticipantsRequest) (response BulkAddParticipantsResponse, err error)
 }
 
 // IGroupSettings handles group settings operations
@@ -28,6 +30,26 @@ type IGroupSettings interface {
 	SetGroupLocked(ctx context.Context, request SetGroupLockedRequest) (err error)
 	SetGroupAnnounce(ctx context.Context, request SetGroupAnnounceRequest) (err error)
 	SetGroupTopic(ctx context.Context, request SetGroupTopicRequest) (err error)
+	SetGroupJoinApproval(ctx context.Context, request SetGroupJoinApprovalRequest) (err error)
+	SetGroupMemberAddMode(ctx context.Context, request SetGroupMemberAddModeRequest) (err error)
+	SetGroupEphemeral(ctx context.Context, request SetGroupEphemeralRequest) (err error)
+	RevokeGroupInviteLink(ctx context.Context, request RevokeGroupInviteLinkRequest) (response GetGroupInviteLinkResponse, err error)
+}
+
+// IGroupCommunity handles community operations
+type IGroupCommunity interface {
+	LinkGroup(ctx context.Context, request LinkCommunityGroupRequest) (err error)
+	UnlinkGroup(ctx context.Context, request LinkCommunityGroupRequest) (err error)
+	GetSubGroups(ctx context.Context, request CommunityRequest) (result []CommunitySubGroup, err error)
+	GetCommunityParticipants(ctx context.Context, request CommunityRequest) (response CommunityParticipantsResponse, err error)
+	SendCommunityAnnouncement(ctx context.Context, request CommunityAnnouncementRequest) (response CommunityAnnouncementResponse, err error)
+}
+
+// IGroupMembershipHistory answers questions about stored group membership history
+type IGroupMembershipHistory interface {
+	GetGroupMembership(ctx context.Context, request GroupMembershipRequest) (response GroupMembershipResponse, err error)
+	GetGroupGrowth(ctx context.Context, request GroupMembershipPeriodRequest) (response GroupGrowthResponse, err error)
+	GetGroupChurn(ctx context.Context, request GroupMembershipPeriodRequest) (response GroupChurnResponse, err error)
 }
 
 // IGroupUsecase combines all group interfaces for backward compatibility
@@ -35,4 +57,6 @@ type IGroupUsecase interface {
 	IGroupManagement
 	IGroupParticipants
 	IGroupSettings
+	IGroupCommunity
+	IGroupMembershipHistory
 }
@@ -10,6 +10,7 @@ type IMessageActions interface {
 	ReactMessage(ctx context.Context, request ReactionRequest) (response GenericResponse, err error)
 	RevokeMessage(ctx context.Context, request RevokeRequest) (response GenericResponse, err error)
 	UpdateMessage(ctx context.Context, request UpdateMessageRequest) (response GenericResponse, err error)
+	PinMessage(ctx context.Context, request PinMessageRequest) (response GenericResponse, err error)
 }
 
 // IMessageManagement handles message management operations
@@ -17,6 +18,8 @@ type IMessageManagement interface {
 	DeleteMessage(ctx context.Context, request DeleteRequest) (err error)
 	StarMessage(ctx context.Context, request StarRequest) (err error)
 	DownloadMedia(ctx context.Context, request DownloadMediaRequest) (response DownloadMediaResponse, err error)
+	GetPollResults(ctx context.Context, request PollResultsRequest) (response PollResultsResponse, err error)
+	GetMessageReceipts(ctx context.Context, request MessageReceiptsRequest) (response MessageReceiptsResponse, err error)
 }
 
 // IMessageUsecase combines all message interfaces
@@ -38,6 +38,13 @@ type StarRequest struct {
 	IsStarred bool   `json:"is_starred"`
 }
 
+type PinMessageRequest struct {
+	MessageID string `json:"message_id" uri:"message_id"`
+	Phone     string `json:"phone" form:"phone"`
+	Duration  string `json:"duration" form:"duration"` // 24h, 7d or 30d
+	IsPinned  bool   `json:"is_pinned"`
+}
+
 type DownloadMediaRequest struct {
 	MessageID string `json:"message_id" uri:"message_id"`
 	Phone     string `json:"phone" form:"phone"`
@@ -51,3 +58,41 @@ type DownloadMediaResponse struct {
 	FilePath  string `json:"file_path"`
 	FileSize  int64  `json:"file_size"`
 }
+
+type PollResultsRequest struct {
+	MessageID string `json:"message_id" uri:"message_id"`
+}
+
+type MessageReceiptsRequest struct {
+	MessageID string `json:"message_id" uri:"message_id"`
+}
+
+type MessageReceipt struct {
+	Participant string `json:"participant"`
+	Status      string `json:"status"`
+	DeliveredAt string `json:"delivered_at,omitempty"`
+	ReadAt      string `json:"read_at,omitempty"`
+	PlayedAt    string `json:"played_at,omitempty"`
+}
+
+type MessageReceiptsResponse struct {
+	MessageID string           `json:"message_id"`
+	ChatJID   string           `json:"chat_jid"`
+	Status    string           `json:"status"`
+	Receipts  []MessageReceipt `json:"receipts"`
+}
+
+type PollOptionResult struct {
+	Option string   `json:"option"`
+	Votes  int      `json:"votes"`
+	Voters []string `json:"voters"`
+}
+
+type PollResultsResponse struct {
+	MessageID   string             `json:"message_id"`
+	ChatJID     string             `json:"chat_jid"`
+	Question    string             `json:"question"`
+	MaxAnswer   int                `json:"max_answer"`
+	TotalVoters int                `json:"total_voters"`
+	Options     []PollOptionResult `json:"options"`
+}
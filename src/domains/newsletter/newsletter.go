@@ -1,11 +1,91 @@
 package newsletter
 
-import "context"
+import (
+	"context"
+	"mime/multipart"
+
+	"go.mau.fi/whatsmeow/types"
+)
 
 type INewsletterUsecase interface {
 	Unfollow(ctx context.Context, request UnfollowRequest) (err error)
+	Create(ctx context.Context, request CreateNewsletterRequest) (response NewsletterResponse, err error)
+	Update(ctx context.Context, request UpdateNewsletterRequest) (response NewsletterResponse, err error)
+	Follow(ctx context.Context, request FollowNewsletterRequest) (response NewsletterResponse, err error)
+	Mute(ctx context.Context, request MuteNewsletterRequest) (err error)
+	GetInfo(ctx context.Context, request GetNewsletterInfoRequest) (response NewsletterResponse, err error)
+	Post(ctx context.Context, request PostNewsletterRequest) (response PostNewsletterResponse, err error)
+	GetMessages(ctx context.Context, request GetNewsletterMessagesRequest) (response GetNewsletterMessagesResponse, err error)
 }
 
 type UnfollowRequest struct {
 	NewsletterID string `json:"newsletter_id" form:"newsletter_id"`
 }
+
+type CreateNewsletterRequest struct {
+	Name        string                `json:"name" form:"name"`
+	Description string                `json:"description" form:"description"`
+	Picture     *multipart.FileHeader `json:"picture" form:"picture"`
+}
+
+type UpdateNewsletterRequest struct {
+	NewsletterID string                `json:"newsletter_id" uri:"newsletter_id"`
+	Name         *string               `json:"name" form:"name"`
+	Description  *string               `json:"description" form:"description"`
+	Picture      *multipart.FileHeader `json:"picture" form:"picture"`
+}
+
+type FollowNewsletterRequest struct {
+	Link string `json:"link" form:"link"` // invite link or invite code
+}
+
+type MuteNewsletterRequest struct {
+	NewsletterID string `json:"newsletter_id" uri:"newsletter_id"`
+	Mute         bool   `json:"mute"`
+}
+
+type GetNewsletterInfoRequest struct {
+	Link string `json:"link" query:"link"` // invite link or invite code
+}
+
+type PostNewsletterRequest struct {
+	NewsletterID string                `json:"newsletter_id" uri:"newsletter_id"`
+	Message      string                `json:"message" form:"message"` // text, or caption when media is attached
+	Image        *multipart.FileHeader `json:"image" form:"image"`
+	ImageURL     *string               `json:"image_url" form:"image_url"`
+	Video        *multipart.FileHeader `json:"video" form:"video"`
+	VideoURL     *string               `json:"video_url" form:"video_url"`
+}
+
+type NewsletterResponse struct {
+	Newsletter *types.NewsletterMetadata `json:"newsletter"`
+	InviteLink string                    `json:"invite_link,omitempty"`
+}
+
+type PostNewsletterResponse struct {
+	MessageID string `json:"message_id"`
+	Status    string `json:"status"`
+}
+
+type GetNewsletterMessagesRequest struct {
+	NewsletterID string `json:"newsletter_id" uri:"newsletter_id"`
+	Limit        int    `json:"limit" query:"limit"`
+	Before       int64  `json:"before" query:"before"` // only posts with a lower server ID
+}
+
+type GetNewsletterMessagesResponse struct {
+	NewsletterID string                  `json:"newsletter_id"`
+	Data         []NewsletterMessageInfo `json:"data"`
+}
+
+type NewsletterMessageInfo struct {
+	ServerID       int64          `json:"server_id"`
+	MessageID      string         `json:"message_id"`
+	Type           string         `json:"type"`
+	Content        string         `json:"content"`
+	MediaType      string         `json:"media_type"`
+	Timestamp      string         `json:"timestamp"`
+	ViewsCount     int            `json:"views_count"`
+	ReactionCounts map[string]int `json:"reaction_counts"`
+	UpdatedAt      string         `json:"updated_at"`
+}
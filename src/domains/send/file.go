@@ -6,4 +6,6 @@ type FileRequest struct {
 	BaseRequest
 	File    *multipart.FileHeader `json:"file" form:"file"`
 	Caption string                `json:"caption" form:"caption"`
+	Format  string                `json:"format" form:"format"`
+	FileURL *string               `json:"file_url" form:"file_url"`
 }
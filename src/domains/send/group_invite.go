@@ -0,0 +1,8 @@
+package send
+
+type GroupInviteRequest struct {
+	BaseRequest
+	GroupID    string `json:"group_id" form:"group_id"`
+	Caption    string `json:"caption" form:"caption"`
+	Expiration int    `json:"expiration" form:"expiration"` // seconds until the invite card expires, 0 keeps it valid until the link is revoked
+}
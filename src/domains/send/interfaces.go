@@ -23,6 +23,7 @@ type IInteractionSender interface {
 	SendLink(ctx context.Context, request LinkRequest) (response GenericResponse, err error)
 	SendLocation(ctx context.Context, request LocationRequest) (response GenericResponse, err error)
 	SendPoll(ctx context.Context, request PollRequest) (response GenericResponse, err error)
+	SendGroupInvite(ctx context.Context, request GroupInviteRequest) (response GenericResponse, err error)
 }
 
 // IPresenceSender handles presence-related operations
@@ -31,10 +32,16 @@ type IPresenceSender interface {
 	SendChatPresence(ctx context.Context, request ChatPresenceRequest) (response GenericResponse, err error)
 }
 
+// ITemplateSender handles sending stored message templates
+type ITemplateSender interface {
+	SendTemplate(ctx context.Context, request TemplateRequest) (response GenericResponse, err error)
+}
+
 // ISendUsecase combines all sender interfaces for backward compatibility
 type ISendUsecase interface {
 	ITextSender
 	IMediaSender
 	IInteractionSender
 	IPresenceSender
+	ITemplateSender
 }
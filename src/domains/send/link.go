@@ -4,4 +4,5 @@ type LinkRequest struct {
 	BaseRequest
 	Caption string `json:"caption"`
 	Link    string `json:"link"`
+	Format  string `json:"format"`
 }
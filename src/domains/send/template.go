@@ -0,0 +1,8 @@
+package send
+
+type TemplateRequest struct {
+	BaseRequest
+	TemplateName string         `json:"template_name" form:"template_name"`
+	Version      int            `json:"version" form:"version"`
+	Variables    map[string]any `json:"variables" form:"variables"`
+}
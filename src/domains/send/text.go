@@ -2,6 +2,8 @@ package send
 
 type MessageRequest struct {
 	BaseRequest
-	Message        string  `json:"message" form:"message"`
-	ReplyMessageID *string `json:"reply_message_id" form:"reply_message_id"`
+	Message            string  `json:"message" form:"message"`
+	ReplyMessageID     *string `json:"reply_message_id" form:"reply_message_id"`
+	DisableLinkPreview bool    `json:"disable_link_preview" form:"disable_link_preview"`
+	Format             string  `json:"format" form:"format"`
 }
@@ -0,0 +1,52 @@
+package template
+
+import (
+	"context"
+	"time"
+)
+
+type ITemplateUsecase interface {
+	SaveTemplate(ctx context.Context, request SaveTemplateRequest) (response TemplateResponse, err error)
+	GetTemplate(ctx context.Context, request GetTemplateRequest) (response TemplateResponse, err error)
+	ListTemplates(ctx context.Context) (response []TemplateResponse, err error)
+	ListTemplateVersions(ctx context.Context, request GetTemplateRequest) (response []TemplateResponse, err error)
+	DeleteTemplate(ctx context.Context, request GetTemplateRequest) (err error)
+	PreviewTemplate(ctx context.Context, request PreviewTemplateRequest) (response PreviewTemplateResponse, err error)
+}
+
+// SaveTemplateRequest creates a template, or a new version when the name already exists
+type SaveTemplateRequest struct {
+	Name      string `json:"name" form:"name"`
+	Body      string `json:"body" form:"body"`
+	MediaType string `json:"media_type" form:"media_type"`
+	MediaURL  string `json:"media_url" form:"media_url"`
+}
+
+type GetTemplateRequest struct {
+	Name    string `json:"name" uri:"name"`
+	Version int    `json:"version" query:"version"`
+}
+
+type PreviewTemplateRequest struct {
+	Name      string         `json:"name" uri:"name"`
+	Version   int            `json:"version" form:"version"`
+	Variables map[string]any `json:"variables" form:"variables"`
+}
+
+type TemplateResponse struct {
+	Name      string    `json:"name"`
+	Version   int       `json:"version"`
+	Body      string    `json:"body"`
+	MediaType string    `json:"media_type,omitempty"`
+	MediaURL  string    `json:"media_url,omitempty"`
+	Variables []string  `json:"variables"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PreviewTemplateResponse struct {
+	Name      string `json:"name"`
+	Version   int    `json:"version"`
+	Body      string `json:"body"`
+	MediaType string `json:"media_type,omitempty"`
+	MediaURL  string `json:"media_url,omitempty"`
+}
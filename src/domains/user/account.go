@@ -44,9 +44,23 @@ type AvatarResponse struct {
 type MyPrivacySettingResponse struct {
 	GroupAdd     string `json:"group_add"`
 	LastSeen     string `json:"last_seen"`
-	Status       string `json:"status"`
+	About        string `json:"about"`
+	Status       string `json:"status"` // Deprecated: same value as About, kept for older clients
 	Profile      string `json:"profile"`
 	ReadReceipts string `json:"read_receipts"`
+	Online       string `json:"online"`
+	CallAdd      string `json:"call_add"`
+}
+
+// UpdatePrivacySettingRequest is a provisioning profile of privacy settings, empty fields are left unchanged
+type UpdatePrivacySettingRequest struct {
+	LastSeen     string `json:"last_seen" form:"last_seen" yaml:"last_seen"`
+	Online       string `json:"online" form:"online" yaml:"online"`
+	Profile      string `json:"profile" form:"profile" yaml:"profile"`
+	About        string `json:"about" form:"about" yaml:"about"`
+	ReadReceipts string `json:"read_receipts" form:"read_receipts" yaml:"read_receipts"`
+	GroupAdd     string `json:"group_add" form:"group_add" yaml:"group_add"`
+	CallAdd      string `json:"call_add" form:"call_add" yaml:"call_add"`
 }
 
 type MyListGroupsResponse struct {
@@ -107,3 +121,67 @@ type BusinessProfileResponse struct {
 	BusinessHoursTimeZone string                       `json:"business_hours_timezone"`
 	BusinessHours         []BusinessProfileHoursConfig `json:"business_hours"`
 }
+
+type BlockContactRequest struct {
+	Phone string `json:"phone" form:"phone"`
+}
+
+type BlocklistResponse struct {
+	DHash string   `json:"dhash"`
+	Data  []string `json:"data"`
+}
+
+type SubscribePresenceRequest struct {
+	Phone string `json:"phone" form:"phone"`
+}
+
+type PresenceRequest struct {
+	Phone string `json:"phone" query:"phone"`
+}
+
+type PresenceResponse struct {
+	JID           string `json:"jid"`
+	Subscribed    bool   `json:"subscribed"`
+	IsOnline      bool   `json:"is_online"`
+	LastSeen      string `json:"last_seen,omitempty"`
+	ChatState     string `json:"chat_state,omitempty"`
+	ChatStateChat string `json:"chat_state_chat,omitempty"`
+	ChatStateAt   string `json:"chat_state_at,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+type ChangeAboutRequest struct {
+	About string `json:"about" form:"about" yaml:"about"`
+}
+
+// DeclarativeProfile describes the desired profile of the account, applied at startup from a YAML or JSON file
+type DeclarativeProfile struct {
+	PushName string                       `json:"push_name" yaml:"push_name"`
+	About    *string                      `json:"about" yaml:"about"`
+	Privacy  *UpdatePrivacySettingRequest `json:"privacy" yaml:"privacy"`
+}
+
+type BulkCheckRequest struct {
+	Phones []string              `json:"phones" form:"phones"`
+	File   *multipart.FileHeader `json:"file" form:"file"`
+}
+
+type BulkCheckResult struct {
+	Phone        string `json:"phone"`
+	JID          string `json:"jid,omitempty"`
+	IsOnWhatsApp bool   `json:"is_on_whatsapp"`
+	IsBusiness   bool   `json:"is_business"`
+	VerifiedName string `json:"verified_name,omitempty"`
+	CheckedAt    string `json:"checked_at,omitempty"`
+	Cached       bool   `json:"cached"`
+	Error        string `json:"error,omitempty"`
+}
+
+type BulkCheckResponse struct {
+	Total         int               `json:"total"`
+	OnWhatsApp    int               `json:"on_whatsapp"`
+	NotOnWhatsApp int               `json:"not_on_whatsapp"`
+	Invalid       int               `json:"invalid"`
+	Cached        int               `json:"cached"`
+	Results       []BulkCheckResult `json:"results"`
+}
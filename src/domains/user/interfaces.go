@@ -8,6 +8,7 @@ import (
 type IUserInfo interface {
 	Info(ctx context.Context, request InfoRequest) (response InfoResponse, err error)
 	IsOnWhatsApp(ctx context.Context, request CheckRequest) (response CheckResponse, err error)
+	BulkIsOnWhatsApp(ctx context.Context, request BulkCheckRequest) (response BulkCheckResponse, err error)
 	BusinessProfile(ctx context.Context, request BusinessProfileRequest) (response BusinessProfileResponse, err error)
 }
 
@@ -16,6 +17,8 @@ type IUserProfile interface {
 	Avatar(ctx context.Context, request AvatarRequest) (response AvatarResponse, err error)
 	ChangeAvatar(ctx context.Context, request ChangeAvatarRequest) (err error)
 	ChangePushName(ctx context.Context, request ChangePushNameRequest) (err error)
+	ChangeAbout(ctx context.Context, request ChangeAboutRequest) (err error)
+	ApplyProfile(ctx context.Context, profile DeclarativeProfile) (err error)
 }
 
 // IUserListing handles user listing operations
@@ -28,6 +31,21 @@ type IUserListing interface {
 // IUserPrivacy handles user privacy operations
 type IUserPrivacy interface {
 	MyPrivacySetting(ctx context.Context) (response MyPrivacySettingResponse, err error)
+	UpdatePrivacySetting(ctx context.Context, request UpdatePrivacySettingRequest) (response MyPrivacySettingResponse, err error)
+}
+
+// IUserBlocklist handles contact blocking operations
+type IUserBlocklist interface {
+	BlockContact(ctx context.Context, request BlockContactRequest) (response BlocklistResponse, err error)
+	UnblockContact(ctx context.Context, request BlockContactRequest) (response BlocklistResponse, err error)
+	GetBlocklist(ctx context.Context) (response BlocklistResponse, err error)
+}
+
+// IUserPresence handles contact presence operations
+type IUserPresence interface {
+	SubscribePresence(ctx context.Context, request SubscribePresenceRequest) (response PresenceResponse, err error)
+	UnsubscribePresence(ctx context.Context, request SubscribePresenceRequest) (response PresenceResponse, err error)
+	GetPresence(ctx context.Context, request PresenceRequest) (response PresenceResponse, err error)
 }
 
 // IUserUsecase combines all user interfaces for backward compatibility
@@ -36,4 +54,6 @@ type IUserUsecase interface {
 	IUserProfile
 	IUserListing
 	IUserPrivacy
+	IUserBlocklist
+	IUserPresence
 }
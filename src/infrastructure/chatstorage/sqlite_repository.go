@@ -3,7 +3,9 @@ package chatstorage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +45,74 @@ func (r *SQLiteRepository) StoreChat(chat *domainChatStorage.Chat) error {
 	return err
 }
 
+// UpdateChatEphemeralExpiration sets the disappearing-message timer of a chat, creating the chat if it is not stored yet
+func (r *SQLiteRepository) UpdateChatEphemeralExpiration(jid string, expiration uint32) error {
+	now := time.Now()
+
+	result, err := r.db.Exec(`
+		UPDATE chats SET ephemeral_expiration = ?, updated_at = ? WHERE jid = ?
+	`, expiration, now, jid)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected > 0 {
+		return err
+	}
+
+	name := jid
+	if parsed, err := types.ParseJID(jid); err == nil {
+		name = r.GetChatNameWithPushName(parsed, jid, "", "")
+	}
+
+	return r.StoreChat(&domainChatStorage.Chat{
+		JID:                 jid,
+		Name:                name,
+		LastMessageTime:     now,
+		EphemeralExpiration: expiration,
+	})
+}
+
+// UpdateChatName renames a stored chat, creating the chat if it is not stored yet
+func (r *SQLiteRepository) UpdateChatName(jid string, name string) error {
+	now := time.Now()
+
+	result, err := r.db.Exec(`
+		UPDATE chats SET name = ?, updated_at = ? WHERE jid = ?
+	`, name, now, jid)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected > 0 {
+		return err
+	}
+
+	return r.StoreChat(&domainChatStorage.Chat{
+		JID:             jid,
+		Name:            name,
+		LastMessageTime: now,
+	})
+}
+
+// UpdateChatLastReadTime records the timestamp of the newest message of a chat this service marked as read
+func (r *SQLiteRepository) UpdateChatLastReadTime(jid string, readAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE chats SET last_read_at = ? WHERE jid = ?`, readAt, jid)
+	return err
+}
+
+// GetChatLastReadTime returns the timestamp of the newest message of a chat this service marked as read, or the
+// zero time when none was marked yet
+func (r *SQLiteRepository) GetChatLastReadTime(jid string) (time.Time, error) {
+	var readAt sql.NullTime
+	err := r.db.QueryRow(`SELECT last_read_at FROM chats WHERE jid = ?`, jid).Scan(&readAt)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return readAt.Time, nil
+}
+
 // GetChat retrieves a chat by JID
 func (r *SQLiteRepository) GetChat(jid string) (*domainChatStorage.Chat, error) {
 	query := `
@@ -65,7 +135,7 @@ func (r *SQLiteRepository) GetMessageByID(id string) (*domainChatStorage.Message
 	query := `
 		SELECT id, chat_jid, sender, content, timestamp, is_from_me,
 			media_type, filename, url, media_key, file_sha256,
-			file_enc_sha256, file_length, created_at, updated_at
+			file_enc_sha256, file_length, edited_at, created_at, updated_at
 		FROM messages
 		WHERE id = ?
 		LIMIT 1
@@ -302,7 +372,7 @@ func (r *SQLiteRepository) GetMessages(filter *domainChatStorage.MessageFilter)
 	query := `
 		SELECT id, chat_jid, sender, content, timestamp, is_from_me,
 			media_type, filename, url, media_key, file_sha256,
-			file_enc_sha256, file_length, created_at, updated_at
+			file_enc_sha256, file_length, edited_at, created_at, updated_at
 		FROM messages
 		WHERE ` + strings.Join(conditions, " AND ") + `
 		ORDER BY timestamp DESC
@@ -362,7 +432,7 @@ func (r *SQLiteRepository) SearchMessages(chatJID, searchText string, limit int)
 	query := `
 		SELECT id, chat_jid, sender, content, timestamp, is_from_me,
 			media_type, filename, url, media_key, file_sha256,
-			file_enc_sha256, file_length, created_at, updated_at
+			file_enc_sha256, file_length, edited_at, created_at, updated_at
 		FROM messages
 		WHERE ` + strings.Join(conditions, " AND ") + `
 		ORDER BY timestamp DESC
@@ -416,12 +486,16 @@ func (r *SQLiteRepository) getCount(query string, args ...any) (int64, error) {
 // scanMessage is a private helper for scanning message rows
 func (r *SQLiteRepository) scanMessage(scanner interface{ Scan(...any) error }) (*domainChatStorage.Message, error) {
 	message := &domainChatStorage.Message{}
+	var editedAt sql.NullTime
 	err := scanner.Scan(
 		&message.ID, &message.ChatJID, &message.Sender, &message.Content,
 		&message.Timestamp, &message.IsFromMe, &message.MediaType, &message.Filename,
 		&message.URL, &message.MediaKey, &message.FileSHA256, &message.FileEncSHA256,
-		&message.FileLength, &message.CreatedAt, &message.UpdatedAt,
+		&message.FileLength, &editedAt, &message.CreatedAt, &message.UpdatedAt,
 	)
+	if editedAt.Valid {
+		message.EditedAt = &editedAt.Time
+	}
 	return message, err
 }
 
@@ -435,222 +509,1260 @@ func (r *SQLiteRepository) scanChat(scanner interface{ Scan(...any) error }) (*d
 	return chat, err
 }
 
-// GetChatMessageCount returns the number of messages in a chat
-func (r *SQLiteRepository) GetChatMessageCount(chatJID string) (int64, error) {
-	return r.getCount("SELECT COUNT(*) FROM messages WHERE chat_jid = ?", chatJID)
-}
+// StorePoll creates or updates a poll and its options
+func (r *SQLiteRepository) StorePoll(poll *domainChatStorage.Poll) error {
+	options, err := json.Marshal(poll.Options)
+	if err != nil {
+		return fmt.Errorf("failed to encode poll options: %w", err)
+	}
 
-// GetTotalMessageCount returns the total number of messages
-func (r *SQLiteRepository) GetTotalMessageCount() (int64, error) {
-	return r.getCount("SELECT COUNT(*) FROM messages")
-}
+	if poll.CreatedAt.IsZero() {
+		poll.CreatedAt = time.Now()
+	}
 
-// GetTotalChatCount returns the total number of chats
-func (r *SQLiteRepository) GetTotalChatCount() (int64, error) {
-	return r.getCount("SELECT COUNT(*) FROM chats")
+	query := `
+		INSERT INTO polls (message_id, chat_jid, creator, question, options, max_answer, secret, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(message_id) DO UPDATE SET
+			chat_jid = excluded.chat_jid,
+			creator = excluded.creator,
+			question = excluded.question,
+			options = excluded.options,
+			max_answer = excluded.max_answer,
+			secret = COALESCE(excluded.secret, polls.secret)
+	`
+
+	_, err = r.db.Exec(query,
+		poll.MessageID, poll.ChatJID, poll.Creator, poll.Question,
+		string(options), poll.MaxAnswer, poll.Secret, poll.CreatedAt,
+	)
+	return err
 }
 
-// TruncateAllChats deletes all chats from the database
-// Note: Due to foreign key constraints, messages must be deleted first
-func (r *SQLiteRepository) TruncateAllChats() error {
-	tx, err := r.db.Begin()
+// GetPoll retrieves a poll by its message ID
+func (r *SQLiteRepository) GetPoll(messageID string) (*domainChatStorage.Poll, error) {
+	query := `
+		SELECT message_id, chat_jid, creator, question, options, max_answer, secret, created_at
+		FROM polls
+		WHERE message_id = ?
+	`
+
+	poll := &domainChatStorage.Poll{}
+	var options string
+	err := r.db.QueryRow(query, messageID).Scan(
+		&poll.MessageID, &poll.ChatJID, &poll.Creator, &poll.Question,
+		&options, &poll.MaxAnswer, &poll.Secret, &poll.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer tx.Rollback()
 
-	// Delete messages first (foreign key constraint)
-	_, err = tx.Exec("DELETE FROM messages")
-	if err != nil {
-		return fmt.Errorf("failed to delete messages: %w", err)
+	if err := json.Unmarshal([]byte(options), &poll.Options); err != nil {
+		return nil, fmt.Errorf("failed to decode poll options: %w", err)
 	}
 
-	// Delete chats
-	_, err = tx.Exec("DELETE FROM chats")
+	return poll, nil
+}
+
+// StorePollVote records a vote, keeping only the most recent vote of each voter
+func (r *SQLiteRepository) StorePollVote(vote *domainChatStorage.PollVote) error {
+	selected, err := json.Marshal(vote.SelectedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to delete chats: %w", err)
+		return fmt.Errorf("failed to encode selected options: %w", err)
 	}
 
-	return tx.Commit()
+	query := `
+		INSERT INTO poll_votes (poll_message_id, voter, selected_options, vote_message_id, voted_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(poll_message_id, voter) DO UPDATE SET
+			selected_options = excluded.selected_options,
+			vote_message_id = excluded.vote_message_id,
+			voted_at = excluded.voted_at
+		WHERE excluded.voted_at >= poll_votes.voted_at
+	`
+
+	_, err = r.db.Exec(query, vote.PollMessageID, vote.Voter, string(selected), vote.VoteMessageID, vote.VotedAt)
+	return err
 }
 
-// GetChatNameWithPushName determines the appropriate name for a chat with pushname support
-func (r *SQLiteRepository) GetChatNameWithPushName(jid types.JID, chatJID string, senderUser string, pushName string) string {
-	// First, check if chat already exists with a name
-	existingChat, err := r.GetChat(chatJID)
-	if err == nil && existingChat != nil && existingChat.Name != "" {
-		// If we have a pushname and the existing name is just a phone number/JID user, update it
-		if pushName != "" && (existingChat.Name == jid.User || existingChat.Name == senderUser) {
-			return pushName
-		}
-		return existingChat.Name
-	}
+// GetPollVotes retrieves the latest vote of every voter on a poll
+func (r *SQLiteRepository) GetPollVotes(pollMessageID string) ([]*domainChatStorage.PollVote, error) {
+	query := `
+		SELECT poll_message_id, voter, selected_options, vote_message_id, voted_at
+		FROM poll_votes
+		WHERE poll_message_id = ?
+		ORDER BY voted_at ASC
+	`
 
-	// Determine chat type and name
-	var name string
+	rows, err := r.db.Query(query, pollMessageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	switch jid.Server {
-	case "g.us":
-		// This is a group chat
-		// For now, use a generic name - this can be enhanced later with group info
-		name = fmt.Sprintf("Group %s", jid.User)
-	case "newsletter":
-		// This is a newsletter/channel
-		name = fmt.Sprintf("Newsletter %s", jid.User)
-	default:
-		// This is an individual contact
-		// Priority: pushName > senderUser > JID user
-		if pushName != "" && pushName != senderUser && pushName != jid.User {
-			name = pushName
-		} else if senderUser != "" {
-			name = senderUser
-		} else {
-			name = jid.User
+	var votes []*domainChatStorage.PollVote
+	for rows.Next() {
+		vote := &domainChatStorage.PollVote{}
+		var selected string
+		if err := rows.Scan(&vote.PollMessageID, &vote.Voter, &selected, &vote.VoteMessageID, &vote.VotedAt); err != nil {
+			return nil, err
 		}
+		if err := json.Unmarshal([]byte(selected), &vote.SelectedOptions); err != nil {
+			return nil, fmt.Errorf("failed to decode selected options: %w", err)
+		}
+		votes = append(votes, vote)
 	}
 
-	return name
+	return votes, rows.Err()
 }
 
-func (r *SQLiteRepository) CreateMessage(ctx context.Context, evt *events.Message) error {
-	if evt == nil || evt.Message == nil {
-		return nil
+// StoreMessageEdit replaces the content of an edited message and appends the change to its edit history
+func (r *SQLiteRepository) StoreMessageEdit(edit *domainChatStorage.MessageEdit) error {
+	if edit.EditedAt.IsZero() {
+		edit.EditedAt = time.Now()
 	}
 
-	// Extract chat and sender information
-	chatJID := evt.Info.Chat.String()
-	// Store the full sender JID (user@server) to ensure consistency between received and sent messages
-	sender := evt.Info.Sender.String()
-
-	// Get appropriate chat name using pushname if available
-	chatName := r.GetChatNameWithPushName(evt.Info.Chat, chatJID, evt.Info.Sender.User, evt.Info.PushName)
-
-	// Get existing chat to preserve ephemeral_expiration if needed
-	existingChat, err := r.GetChat(chatJID)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to get existing chat: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	// Extract ephemeral expiration from incoming message
-	ephemeralExpiration := utils.ExtractEphemeralExpiration(evt.Message)
-
-	// Create or update chat
-	chat := &domainChatStorage.Chat{
-		JID:             chatJID,
-		Name:            chatName,
-		LastMessageTime: evt.Info.Timestamp,
+	// The original message may be unknown (e.g. sent before this device was linked), the history is kept anyway
+	err = tx.QueryRow("SELECT content FROM messages WHERE id = ? AND chat_jid = ?", edit.MessageID, edit.ChatJID).Scan(&edit.PreviousContent)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to get edited message: %w", err)
 	}
 
-	// Set ephemeral expiration: use incoming message value if > 0, otherwise preserve existing
-	if ephemeralExpiration > 0 {
-		chat.EphemeralExpiration = ephemeralExpiration
-	} else if existingChat != nil {
-		// Preserve existing ephemeral_expiration if incoming message doesn't have one
-		chat.EphemeralExpiration = existingChat.EphemeralExpiration
+	if err == nil {
+		_, err = tx.Exec(`
+			UPDATE messages SET content = ?, edited_at = ?, updated_at = ?
+			WHERE id = ? AND chat_jid = ?
+		`, edit.NewContent, edit.EditedAt, time.Now(), edit.MessageID, edit.ChatJID)
+		if err != nil {
+			return fmt.Errorf("failed to update edited message: %w", err)
+		}
 	}
 
-	// Store or update the chat
-	if err := r.StoreChat(chat); err != nil {
-		return fmt.Errorf("failed to store chat: %w", err)
+	_, err = tx.Exec(`
+		INSERT INTO message_edits (message_id, chat_jid, editor, previous_content, new_content, edited_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, edit.MessageID, edit.ChatJID, edit.Editor, edit.PreviousContent, edit.NewContent, edit.EditedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store message edit: %w", err)
 	}
 
-	// Extract message content and media info
-	content := utils.ExtractMessageTextFromProto(evt.Message)
-	mediaType, filename, url, mediaKey, fileSHA256, fileEncSHA256, fileLength := utils.ExtractMediaInfo(evt.Message)
+	return tx.Commit()
+}
 
-	// Skip if there's no content and no media
-	if content == "" && mediaType == "" {
-		logrus.Debugf("Skipping message %s - no content or media", evt.Info.ID)
-		return nil
+// GetMessageEdits retrieves the edit history of a message, oldest first
+func (r *SQLiteRepository) GetMessageEdits(messageID, chatJID string) ([]*domainChatStorage.MessageEdit, error) {
+	rows, err := r.db.Query(`
+		SELECT message_id, chat_jid, editor, previous_content, new_content, edited_at
+		FROM message_edits
+		WHERE message_id = ? AND chat_jid = ?
+		ORDER BY edited_at ASC, id ASC
+	`, messageID, chatJID)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	// Create message object
-	message := &domainChatStorage.Message{
-		ID:            evt.Info.ID,
-		ChatJID:       chatJID,
-		Sender:        sender,
-		Content:       content,
-		Timestamp:     evt.Info.Timestamp,
-		IsFromMe:      evt.Info.IsFromMe,
-		MediaType:     mediaType,
-		Filename:      filename,
-		URL:           url,
-		MediaKey:      mediaKey,
-		FileSHA256:    fileSHA256,
-		FileEncSHA256: fileEncSHA256,
-		FileLength:    fileLength,
+	var edits []*domainChatStorage.MessageEdit
+	for rows.Next() {
+		edit := &domainChatStorage.MessageEdit{}
+		if err := rows.Scan(&edit.MessageID, &edit.ChatJID, &edit.Editor, &edit.PreviousContent, &edit.NewContent, &edit.EditedAt); err != nil {
+			return nil, err
+		}
+		edits = append(edits, edit)
 	}
 
-	// Store the message
-	return r.StoreMessage(message)
+	return edits, rows.Err()
 }
 
-// GetStorageStatistics returns current storage statistics for logging purposes
-func (r *SQLiteRepository) GetStorageStatistics() (chatCount int64, messageCount int64, err error) {
-	// Count all chats using efficient query
-	chatCount, err = r.GetTotalChatCount()
-	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get chat count: %w", err)
+// StoreMessageReceipts records that a recipient reached status for the given messages. A later status implies the
+// earlier ones, and the time each status was first reported is kept.
+func (r *SQLiteRepository) StoreMessageReceipts(chatJID, participant, status string, messageIDs []string, timestamp time.Time) error {
+	if len(messageIDs) == 0 {
+		return nil
 	}
 
-	// Count all messages
-	messageCount, err = r.GetTotalMessageCount()
-	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get message count: %w", err)
+	timestamp = timestamp.UTC()
+	var readAt, playedAt any
+	switch status {
+	case domainChatStorage.MessageStatusDelivered:
+	case domainChatStorage.MessageStatusRead:
+		readAt = timestamp
+	case domainChatStorage.MessageStatusPlayed:
+		readAt = timestamp
+		playedAt = timestamp
+	default:
+		return fmt.Errorf("unknown receipt status %q", status)
 	}
 
-	return chatCount, messageCount, nil
-}
-
-// TruncateAllDataWithLogging performs truncation with detailed logging
-func (r *SQLiteRepository) TruncateAllDataWithLogging(logPrefix string) error {
-	// Get statistics before truncation
-	chatCount, messageCount, err := r.GetStorageStatistics()
+	tx, err := r.db.Begin()
 	if err != nil {
-		logrus.Warnf("[%s] Failed to get storage statistics before truncation: %v", logPrefix, err)
-	} else {
-		logrus.Infof("[%s] Storage before truncation: %d chats, %d messages", logPrefix, chatCount, messageCount)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	// Perform truncation
-	if err := r.TruncateAllChats(); err != nil {
-		return fmt.Errorf("failed to truncate chatstorage data: %w", err)
+	stmt, err := tx.Prepare(`
+		INSERT INTO message_receipts (message_id, chat_jid, participant, delivered_at, read_at, played_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(message_id, chat_jid, participant) DO UPDATE SET
+			delivered_at = COALESCE(message_receipts.delivered_at, excluded.delivered_at),
+			read_at = COALESCE(message_receipts.read_at, excluded.read_at),
+			played_at = COALESCE(message_receipts.played_at, excluded.played_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
 	}
+	defer stmt.Close()
 
-	// Verify truncation
-	chatCountAfter, messageCountAfter, err := r.GetStorageStatistics()
-	if err != nil {
-		logrus.Warnf("[%s] Failed to get storage statistics after truncation: %v", logPrefix, err)
-	} else {
-		logrus.Infof("[%s] Storage after truncation: %d chats, %d messages", logPrefix, chatCountAfter, messageCountAfter)
-		if chatCountAfter == 0 && messageCountAfter == 0 {
-			logrus.Infof("[%s] ✅ Chatstorage truncation completed successfully", logPrefix)
-		} else {
-			logrus.Warnf("[%s] ⚠️ Truncation may not have completed fully", logPrefix)
+	for _, messageID := range messageIDs {
+		if _, err := stmt.Exec(messageID, chatJID, participant, timestamp, readAt, playedAt); err != nil {
+			return fmt.Errorf("failed to store message receipt: %w", err)
 		}
 	}
 
-	return nil
+	return tx.Commit()
 }
 
-// StoreSentMessageWithContext stores a message that was sent by the user with context cancellation support
-func (r *SQLiteRepository) StoreSentMessageWithContext(ctx context.Context, messageID string, senderJID string, recipientJID string, content string, timestamp time.Time) error {
-	// Check if context is already cancelled before starting
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	default:
+// GetMessageReceipts returns the receipts of messages in a chat, ordered by message and participant
+func (r *SQLiteRepository) GetMessageReceipts(chatJID string, messageIDs ...string) ([]*domainChatStorage.MessageReceipt, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
 	}
 
-	// Ensure JID is properly formatted
-	jid, err := types.ParseJID(recipientJID)
-	if err != nil {
-		return fmt.Errorf("invalid JID format: %w", err)
+	args := make([]any, 0, len(messageIDs)+1)
+	args = append(args, chatJID)
+	for _, messageID := range messageIDs {
+		args = append(args, messageID)
 	}
 
-	chatJID := jid.String()
+	rows, err := r.db.Query(`
+		SELECT message_id, chat_jid, participant, delivered_at, read_at, played_at
+		FROM message_receipts
+		WHERE chat_jid = ? AND message_id IN (?`+strings.Repeat(", ?", len(messageIDs)-1)+`)
+		ORDER BY message_id, participant
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	// Get chat name (no pushname available for sent messages)
+	var receipts []*domainChatStorage.MessageReceipt
+	for rows.Next() {
+		receipt := &domainChatStorage.MessageReceipt{}
+		var deliveredAt, readAt, playedAt sql.NullTime
+		if err := rows.Scan(&receipt.MessageID, &receipt.ChatJID, &receipt.Participant, &deliveredAt, &readAt, &playedAt); err != nil {
+			return nil, err
+		}
+		if deliveredAt.Valid {
+			receipt.DeliveredAt = &deliveredAt.Time
+		}
+		if readAt.Valid {
+			receipt.ReadAt = &readAt.Time
+		}
+		if playedAt.Valid {
+			receipt.PlayedAt = &playedAt.Time
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, rows.Err()
+}
+
+// StoreMessageReaction sets the reaction of a person to a message, replacing their earlier reaction unless it is newer
+func (r *SQLiteRepository) StoreMessageReaction(reaction *domainChatStorage.MessageReaction) error {
+	_, err := r.db.Exec(`
+		INSERT INTO message_reactions (message_id, chat_jid, reactor, emoji, reacted_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(message_id, chat_jid, reactor) DO UPDATE SET
+			emoji = excluded.emoji,
+			reacted_at = excluded.reacted_at
+		WHERE excluded.reacted_at >= message_reactions.reacted_at
+	`, reaction.MessageID, reaction.ChatJID, reaction.Reactor, reaction.Emoji, reaction.ReactedAt.UTC())
+	return err
+}
+
+// DeleteMessageReaction removes the reaction of a person to a message, a reaction set after removedAt is kept
+func (r *SQLiteRepository) DeleteMessageReaction(messageID, chatJID, reactor string, removedAt time.Time) error {
+	_, err := r.db.Exec(`
+		DELETE FROM message_reactions
+		WHERE message_id = ? AND chat_jid = ? AND reactor = ? AND reacted_at <= ?
+	`, messageID, chatJID, reactor, removedAt.UTC())
+	return err
+}
+
+// GetMessageReactions returns the reactions to messages in a chat, oldest first
+func (r *SQLiteRepository) GetMessageReactions(chatJID string, messageIDs ...string) ([]*domainChatStorage.MessageReaction, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, 0, len(messageIDs)+1)
+	args = append(args, chatJID)
+	for _, messageID := range messageIDs {
+		args = append(args, messageID)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT message_id, chat_jid, reactor, emoji, reacted_at
+		FROM message_reactions
+		WHERE chat_jid = ? AND message_id IN (?`+strings.Repeat(", ?", len(messageIDs)-1)+`)
+		ORDER BY reacted_at, reactor
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reactions []*domainChatStorage.MessageReaction
+	for rows.Next() {
+		reaction := &domainChatStorage.MessageReaction{}
+		if err := rows.Scan(&reaction.MessageID, &reaction.ChatJID, &reaction.Reactor, &reaction.Emoji, &reaction.ReactedAt); err != nil {
+			return nil, err
+		}
+		reactions = append(reactions, reaction)
+	}
+
+	return reactions, rows.Err()
+}
+
+// StoreNewsletterMessage creates or updates a newsletter post, keeping stored content and counts when the update carries none
+func (r *SQLiteRepository) StoreNewsletterMessage(message *domainChatStorage.NewsletterMessage) error {
+	reactions, err := json.Marshal(message.ReactionCounts)
+	if err != nil {
+		return fmt.Errorf("failed to encode reaction counts: %w", err)
+	}
+
+	_, err = r.db.Exec(`
+		INSERT INTO newsletter_messages (newsletter_jid, server_id, message_id, type, content, media_type, timestamp, views_count, reaction_counts, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(newsletter_jid, server_id) DO UPDATE SET
+			message_id = CASE WHEN excluded.message_id != '' THEN excluded.message_id ELSE newsletter_messages.message_id END,
+			type = CASE WHEN excluded.type != '' THEN excluded.type ELSE newsletter_messages.type END,
+			content = CASE WHEN excluded.content != '' THEN excluded.content ELSE newsletter_messages.content END,
+			media_type = CASE WHEN excluded.media_type != '' THEN excluded.media_type ELSE newsletter_messages.media_type END,
+			views_count = MAX(excluded.views_count, newsletter_messages.views_count),
+			reaction_counts = CASE WHEN excluded.reaction_counts NOT IN ('null', '{}') THEN excluded.reaction_counts ELSE newsletter_messages.reaction_counts END,
+			updated_at = excluded.updated_at
+	`, message.NewsletterJID, message.ServerID, message.MessageID, message.Type, message.Content, message.MediaType,
+		message.Timestamp, message.ViewsCount, string(reactions), time.Now())
+	return err
+}
+
+// UpdateNewsletterMessageCounts refreshes the view and reaction counts of a known newsletter post
+func (r *SQLiteRepository) UpdateNewsletterMessageCounts(newsletterJID string, serverID int64, viewsCount int, reactionCounts map[string]int) error {
+	reactions, err := json.Marshal(reactionCounts)
+	if err != nil {
+		return fmt.Errorf("failed to encode reaction counts: %w", err)
+	}
+
+	_, err = r.db.Exec(`
+		UPDATE newsletter_messages SET views_count = ?, reaction_counts = ?, updated_at = ?
+		WHERE newsletter_jid = ? AND server_id = ?
+	`, viewsCount, string(reactions), time.Now(), newsletterJID, serverID)
+	return err
+}
+
+// GetNewsletterMessages retrieves the posts of a newsletter, newest first, optionally older than beforeServerID
+func (r *SQLiteRepository) GetNewsletterMessages(newsletterJID string, limit int, beforeServerID int64) ([]*domainChatStorage.NewsletterMessage, error) {
+	query := `
+		SELECT newsletter_jid, server_id, message_id, type, content, media_type, timestamp, views_count, reaction_counts, updated_at
+		FROM newsletter_messages
+		WHERE newsletter_jid = ?`
+	args := []any{newsletterJID}
+	if beforeServerID > 0 {
+		query += " AND server_id < ?"
+		args = append(args, beforeServerID)
+	}
+	query += " ORDER BY server_id DESC"
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*domainChatStorage.NewsletterMessage
+	for rows.Next() {
+		message := &domainChatStorage.NewsletterMessage{}
+		var reactions string
+		if err := rows.Scan(&message.NewsletterJID, &message.ServerID, &message.MessageID, &message.Type, &message.Content,
+			&message.MediaType, &message.Timestamp, &message.ViewsCount, &reactions, &message.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if reactions != "" {
+			if err := json.Unmarshal([]byte(reactions), &message.ReactionCounts); err != nil {
+				return nil, fmt.Errorf("failed to decode reaction counts: %w", err)
+			}
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, rows.Err()
+}
+
+// StorePinnedMessage creates or refreshes the pin of a message
+func (r *SQLiteRepository) StorePinnedMessage(pin *domainChatStorage.PinnedMessage) error {
+	_, err := r.db.Exec(`
+		INSERT INTO pinned_messages (message_id, chat_jid, pinned_by, pinned_at, expires_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(message_id, chat_jid) DO UPDATE SET
+			pinned_by = excluded.pinned_by,
+			pinned_at = excluded.pinned_at,
+			expires_at = excluded.expires_at
+	`, pin.MessageID, pin.ChatJID, pin.PinnedBy, pin.PinnedAt, pin.ExpiresAt)
+	return err
+}
+
+// DeletePinnedMessage removes the pin of a message
+func (r *SQLiteRepository) DeletePinnedMessage(messageID, chatJID string) error {
+	_, err := r.db.Exec("DELETE FROM pinned_messages WHERE message_id = ? AND chat_jid = ?", messageID, chatJID)
+	return err
+}
+
+// GetPinnedMessages retrieves the pins of a chat that have not expired yet, most recent first
+func (r *SQLiteRepository) GetPinnedMessages(chatJID string) ([]*domainChatStorage.PinnedMessage, error) {
+	rows, err := r.db.Query(`
+		SELECT message_id, chat_jid, pinned_by, pinned_at, expires_at
+		FROM pinned_messages
+		WHERE chat_jid = ? AND expires_at > ?
+		ORDER BY pinned_at DESC
+	`, chatJID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pins []*domainChatStorage.PinnedMessage
+	for rows.Next() {
+		pin := &domainChatStorage.PinnedMessage{}
+		if err := rows.Scan(&pin.MessageID, &pin.ChatJID, &pin.PinnedBy, &pin.PinnedAt, &pin.ExpiresAt); err != nil {
+			return nil, err
+		}
+		pins = append(pins, pin)
+	}
+
+	return pins, rows.Err()
+}
+
+// StoreGroupMembershipEvents appends membership events, ignoring events that were already recorded
+func (r *SQLiteRepository) StoreGroupMembershipEvents(events []*domainChatStorage.GroupMembershipEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT OR IGNORE INTO group_membership_events (
+			group_jid, participant_jid, action, actor_jid, is_admin, timestamp
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, event := range events {
+		// Store at second precision in UTC so timestamps compare consistently
+		timestamp := event.Timestamp.UTC().Truncate(time.Second)
+		if _, err := stmt.Exec(event.GroupJID, event.ParticipantJID, event.Action, event.ActorJID, event.IsAdmin, timestamp); err != nil {
+			return fmt.Errorf("failed to store membership event: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// HasGroupMembershipEvents reports whether anything about the group's membership has been recorded
+func (r *SQLiteRepository) HasGroupMembershipEvents(groupJID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`
+		SELECT EXISTS(SELECT 1 FROM group_membership_events WHERE group_jid = ?)
+	`, groupJID).Scan(&exists)
+	return exists, err
+}
+
+// GetGroupMembershipEvents returns the membership events of a group up to the given time, oldest first.
+// A zero until returns the complete history.
+func (r *SQLiteRepository) GetGroupMembershipEvents(groupJID string, until time.Time) ([]*domainChatStorage.GroupMembershipEvent, error) {
+	query := `
+		SELECT id, group_jid, participant_jid, action, actor_jid, is_admin, timestamp
+		FROM group_membership_events
+		WHERE group_jid = ?
+	`
+	args := []any{groupJID}
+	if !until.IsZero() {
+		query += " AND timestamp <= ?"
+		args = append(args, until.UTC().Truncate(time.Second))
+	}
+	query += " ORDER BY timestamp ASC, id ASC"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domainChatStorage.GroupMembershipEvent
+	for rows.Next() {
+		event := &domainChatStorage.GroupMembershipEvent{}
+		if err := rows.Scan(&event.ID, &event.GroupJID, &event.ParticipantJID, &event.Action, &event.ActorJID, &event.IsAdmin, &event.Timestamp); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// GetGroupMembershipGroups returns every group that has membership history
+func (r *SQLiteRepository) GetGroupMembershipGroups() ([]string, error) {
+	rows, err := r.db.Query("SELECT DISTINCT group_jid FROM group_membership_events ORDER BY group_jid")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []string
+	for rows.Next() {
+		var jid string
+		if err := rows.Scan(&jid); err != nil {
+			return nil, err
+		}
+		groups = append(groups, jid)
+	}
+
+	return groups, rows.Err()
+}
+
+// StoreTemplate saves a template as the next version of its name and sets the assigned version
+func (r *SQLiteRepository) StoreTemplate(template *domainChatStorage.MessageTemplate) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var latest int
+	if err := tx.QueryRow("SELECT COALESCE(MAX(version), 0) FROM message_templates WHERE name = ?", template.Name).Scan(&latest); err != nil {
+		return fmt.Errorf("failed to get latest template version: %w", err)
+	}
+
+	template.Version = latest + 1
+	template.CreatedAt = time.Now()
+
+	_, err = tx.Exec(`
+		INSERT INTO message_templates (name, version, body, media_type, media_url, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, template.Name, template.Version, template.Body, template.MediaType, template.MediaURL, template.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store template: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetTemplate retrieves a template version, or the latest version when version is 0
+func (r *SQLiteRepository) GetTemplate(name string, version int) (*domainChatStorage.MessageTemplate, error) {
+	query := `
+		SELECT name, version, body, media_type, media_url, created_at
+		FROM message_templates
+		WHERE name = ?
+	`
+	args := []any{name}
+	if version > 0 {
+		query += " AND version = ?"
+		args = append(args, version)
+	}
+	query += " ORDER BY version DESC LIMIT 1"
+
+	template, err := r.scanTemplate(r.db.QueryRow(query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return template, err
+}
+
+// GetTemplates retrieves the latest version of every template
+func (r *SQLiteRepository) GetTemplates() ([]*domainChatStorage.MessageTemplate, error) {
+	return r.queryTemplates(`
+		SELECT t.name, t.version, t.body, t.media_type, t.media_url, t.created_at
+		FROM message_templates t
+		INNER JOIN (
+			SELECT name, MAX(version) AS version FROM message_templates GROUP BY name
+		) latest ON latest.name = t.name AND latest.version = t.version
+		ORDER BY t.name ASC
+	`)
+}
+
+// GetTemplateVersions retrieves every version of a template, newest first
+func (r *SQLiteRepository) GetTemplateVersions(name string) ([]*domainChatStorage.MessageTemplate, error) {
+	return r.queryTemplates(`
+		SELECT name, version, body, media_type, media_url, created_at
+		FROM message_templates
+		WHERE name = ?
+		ORDER BY version DESC
+	`, name)
+}
+
+// DeleteTemplate deletes every version of a template
+func (r *SQLiteRepository) DeleteTemplate(name string) error {
+	_, err := r.db.Exec("DELETE FROM message_templates WHERE name = ?", name)
+	return err
+}
+
+// queryTemplates is a private helper for template list queries
+func (r *SQLiteRepository) queryTemplates(query string, args ...any) ([]*domainChatStorage.MessageTemplate, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*domainChatStorage.MessageTemplate
+	for rows.Next() {
+		template, err := r.scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+// scanTemplate is a private helper for scanning template rows
+func (r *SQLiteRepository) scanTemplate(scanner interface{ Scan(...any) error }) (*domainChatStorage.MessageTemplate, error) {
+	template := &domainChatStorage.MessageTemplate{}
+	err := scanner.Scan(
+		&template.Name, &template.Version, &template.Body,
+		&template.MediaType, &template.MediaURL, &template.CreatedAt,
+	)
+	return template, err
+}
+
+// GetLinkPreview retrieves cached link preview metadata by URL
+func (r *SQLiteRepository) GetLinkPreview(url string) (*domainChatStorage.LinkPreview, error) {
+	query := `
+		SELECT url, title, description, image_url, image, width, height, fetched_at
+		FROM link_previews
+		WHERE url = ?
+	`
+
+	preview := &domainChatStorage.LinkPreview{}
+	err := r.db.QueryRow(query, url).Scan(
+		&preview.URL, &preview.Title, &preview.Description, &preview.ImageURL,
+		&preview.Image, &preview.Width, &preview.Height, &preview.FetchedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return preview, err
+}
+
+// StoreLinkPreview creates or refreshes cached link preview metadata
+func (r *SQLiteRepository) StoreLinkPreview(preview *domainChatStorage.LinkPreview) error {
+	query := `
+		INSERT INTO link_previews (url, title, description, image_url, image, width, height, fetched_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(url) DO UPDATE SET
+			title = excluded.title,
+			description = excluded.description,
+			image_url = excluded.image_url,
+			image = excluded.image,
+			width = excluded.width,
+			height = excluded.height,
+			fetched_at = excluded.fetched_at
+	`
+
+	_, err := r.db.Exec(query,
+		preview.URL, preview.Title, preview.Description, preview.ImageURL,
+		preview.Image, preview.Width, preview.Height, preview.FetchedAt,
+	)
+	return err
+}
+
+// GetWhatsAppChecks retrieves the cached registration checks of the given phone numbers that were made after
+// checkedAfter, keyed by phone number. Numbers without a fresh check are missing from the result.
+func (r *SQLiteRepository) GetWhatsAppChecks(phones []string, checkedAfter time.Time) (map[string]*domainChatStorage.WhatsAppCheck, error) {
+	checks := make(map[string]*domainChatStorage.WhatsAppCheck, len(phones))
+
+	// Keep well below SQLite's bound parameter limit
+	const chunkSize = 500
+	for start := 0; start < len(phones); start += chunkSize {
+		chunk := phones[start:min(start+chunkSize, len(phones))]
+
+		args := make([]any, 0, len(chunk)+1)
+		for _, phone := range chunk {
+			args = append(args, phone)
+		}
+		args = append(args, checkedAfter.UTC().Truncate(time.Second))
+
+		query := fmt.Sprintf(`
+			SELECT phone, jid, is_on_whatsapp, is_business, verified_name, checked_at
+			FROM whatsapp_checks
+			WHERE phone IN (?%s) AND checked_at > ?
+		`, strings.Repeat(", ?", len(chunk)-1))
+
+		rows, err := r.db.Query(query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			check := &domainChatStorage.WhatsAppCheck{}
+			if err := rows.Scan(&check.Phone, &check.JID, &check.IsOnWhatsApp, &check.IsBusiness, &check.VerifiedName, &check.CheckedAt); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			checks[check.Phone] = check
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return checks, nil
+}
+
+// StoreWhatsAppChecks creates or refreshes cached registration checks
+func (r *SQLiteRepository) StoreWhatsAppChecks(checks []*domainChatStorage.WhatsAppCheck) error {
+	if len(checks) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO whatsapp_checks (phone, jid, is_on_whatsapp, is_business, verified_name, checked_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(phone) DO UPDATE SET
+			jid = excluded.jid,
+			is_on_whatsapp = excluded.is_on_whatsapp,
+			is_business = excluded.is_business,
+			verified_name = excluded.verified_name,
+			checked_at = excluded.checked_at
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, check := range checks {
+		if _, err := stmt.Exec(check.Phone, check.JID, check.IsOnWhatsApp, check.IsBusiness, check.VerifiedName, check.CheckedAt.UTC().Truncate(time.Second)); err != nil {
+			return fmt.Errorf("failed to store WhatsApp check: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SetPresenceSubscribed records whether presence updates of a contact are subscribed to
+func (r *SQLiteRepository) SetPresenceSubscribed(jid string, subscribed bool) error {
+	_, err := r.db.Exec(`
+		INSERT INTO presence (jid, subscribed, updated_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(jid) DO UPDATE SET subscribed = excluded.subscribed
+	`, jid, subscribed, time.Now().UTC().Truncate(time.Second))
+	return err
+}
+
+// GetPresenceSubscriptions returns the JIDs of all contacts whose presence updates are subscribed to
+func (r *SQLiteRepository) GetPresenceSubscriptions() ([]string, error) {
+	rows, err := r.db.Query("SELECT jid FROM presence WHERE subscribed = TRUE ORDER BY jid")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jids []string
+	for rows.Next() {
+		var jid string
+		if err := rows.Scan(&jid); err != nil {
+			return nil, err
+		}
+		jids = append(jids, jid)
+	}
+	return jids, rows.Err()
+}
+
+// StorePresence records whether a contact is online. A zero lastSeen means the contact hides it,
+// in which case the time they went offline is kept as the best known last seen.
+func (r *SQLiteRepository) StorePresence(jid string, online bool, lastSeen time.Time) error {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var seen any
+	if !lastSeen.IsZero() {
+		seen = lastSeen.UTC().Truncate(time.Second)
+	}
+
+	_, err := r.db.Exec(`
+		INSERT INTO presence (jid, is_online, last_seen, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(jid) DO UPDATE SET
+			last_seen = COALESCE(excluded.last_seen, CASE WHEN presence.is_online AND NOT excluded.is_online THEN excluded.updated_at ELSE presence.last_seen END),
+			is_online = excluded.is_online,
+			updated_at = excluded.updated_at
+	`, jid, online, seen, now)
+	return err
+}
+
+// StoreChatState records that a contact is typing, recording or has paused in a chat
+func (r *SQLiteRepository) StoreChatState(jid, chatJID, state string) error {
+	now := time.Now().UTC().Truncate(time.Second)
+	_, err := r.db.Exec(`
+		INSERT INTO presence (jid, chat_state, chat_state_chat, chat_state_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(jid) DO UPDATE SET
+			chat_state = excluded.chat_state,
+			chat_state_chat = excluded.chat_state_chat,
+			chat_state_at = excluded.chat_state_at
+	`, jid, state, chatJID, now, now)
+	return err
+}
+
+// GetPresence returns the last known presence of a contact, or nil if nothing is known
+func (r *SQLiteRepository) GetPresence(jid string) (*domainChatStorage.Presence, error) {
+	presence := &domainChatStorage.Presence{}
+	var lastSeen, chatStateAt sql.NullTime
+
+	err := r.db.QueryRow(`
+		SELECT jid, subscribed, is_online, last_seen, chat_state, chat_state_chat, chat_state_at, updated_at
+		FROM presence
+		WHERE jid = ?
+	`, jid).Scan(&presence.JID, &presence.Subscribed, &presence.IsOnline, &lastSeen, &presence.ChatState, &presence.ChatStateChat, &chatStateAt, &presence.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lastSeen.Valid {
+		presence.LastSeen = &lastSeen.Time
+	}
+	if chatStateAt.Valid {
+		presence.ChatStateAt = &chatStateAt.Time
+	}
+	return presence, nil
+}
+
+// StoreCall adds a call to the call log, a call that is already logged is left unchanged
+func (r *SQLiteRepository) StoreCall(call *domainChatStorage.Call) error {
+	var endedAt any
+	if call.EndedAt != nil {
+		endedAt = call.EndedAt.UTC()
+	}
+
+	_, err := r.db.Exec(`
+		INSERT INTO calls (id, caller, group_jid, is_group, is_video, status, auto_rejected, reason, started_at, ended_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO NOTHING
+	`, call.ID, call.Caller, call.GroupJID, call.IsGroup, call.IsVideo, call.Status, call.AutoRejected, call.Reason, call.StartedAt.UTC(), endedAt)
+	return err
+}
+
+// GetCall returns a call from the call log, or nil if it is not logged
+func (r *SQLiteRepository) GetCall(callID string) (*domainChatStorage.Call, error) {
+	call, err := r.scanCall(r.db.QueryRow(`
+		SELECT id, caller, group_jid, is_group, is_video, status, auto_rejected, reason, started_at, ended_at
+		FROM calls
+		WHERE id = ?
+	`, callID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return call, err
+}
+
+// UpdateCallStatus changes the status of a logged call. An empty reason and a nil endedAt keep the stored values.
+func (r *SQLiteRepository) UpdateCallStatus(callID, status, reason string, endedAt *time.Time) error {
+	var ended any
+	if endedAt != nil {
+		ended = endedAt.UTC()
+	}
+
+	_, err := r.db.Exec(`
+		UPDATE calls
+		SET status = ?, reason = CASE WHEN ? = '' THEN reason ELSE ? END, ended_at = COALESCE(?, ended_at)
+		WHERE id = ?
+	`, status, reason, reason, ended, callID)
+	return err
+}
+
+// GetCalls returns the call log, newest first
+func (r *SQLiteRepository) GetCalls(filter *domainChatStorage.CallFilter) ([]*domainChatStorage.Call, error) {
+	where, args := callFilterWhere(filter)
+	query := `
+		SELECT id, caller, group_jid, is_group, is_video, status, auto_rejected, reason, started_at, ended_at
+		FROM calls` + where + `
+		ORDER BY started_at DESC`
+
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+		if filter.Offset > 0 {
+			query += " OFFSET ?"
+			args = append(args, filter.Offset)
+		}
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var calls []*domainChatStorage.Call
+	for rows.Next() {
+		call, err := r.scanCall(rows)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+	return calls, rows.Err()
+}
+
+// CountCalls returns the number of logged calls matching the filter, ignoring limit and offset
+func (r *SQLiteRepository) CountCalls(filter *domainChatStorage.CallFilter) (int64, error) {
+	where, args := callFilterWhere(filter)
+	return r.getCount("SELECT COUNT(*) FROM calls"+where, args...)
+}
+
+func callFilterWhere(filter *domainChatStorage.CallFilter) (string, []any) {
+	if filter.Caller == "" {
+		return "", nil
+	}
+	return " WHERE caller = ?", []any{filter.Caller}
+}
+
+func (r *SQLiteRepository) scanCall(scanner interface{ Scan(...any) error }) (*domainChatStorage.Call, error) {
+	call := &domainChatStorage.Call{}
+	var endedAt sql.NullTime
+
+	err := scanner.Scan(&call.ID, &call.Caller, &call.GroupJID, &call.IsGroup, &call.IsVideo, &call.Status, &call.AutoRejected, &call.Reason, &call.StartedAt, &endedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if endedAt.Valid {
+		call.EndedAt = &endedAt.Time
+	}
+	return call, nil
+}
+
+// StoreDefaultEphemeralExpiration records the disappearing timer, in seconds, the account applies to new chats
+func (r *SQLiteRepository) StoreDefaultEphemeralExpiration(expiration uint32) error {
+	_, err := r.db.Exec(`
+		INSERT INTO account_settings (name, value, updated_at)
+		VALUES ('default_ephemeral_expiration', ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			value = excluded.value,
+			updated_at = excluded.updated_at
+	`, strconv.FormatUint(uint64(expiration), 10), time.Now())
+	return err
+}
+
+// GetDefaultEphemeralExpiration returns the disappearing timer, in seconds, the account applies to new chats, or 0
+// when it is off or was never set through this service
+func (r *SQLiteRepository) GetDefaultEphemeralExpiration() (uint32, error) {
+	var value string
+	err := r.db.QueryRow(`SELECT value FROM account_settings WHERE name = 'default_ephemeral_expiration'`).Scan(&value)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	expiration, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid default ephemeral expiration %q: %w", value, err)
+	}
+	return uint32(expiration), nil
+}
+
+// GetChatMessageCount returns the number of messages in a chat
+func (r *SQLiteRepository) GetChatMessageCount(chatJID string) (int64, error) {
+	return r.getCount("SELECT COUNT(*) FROM messages WHERE chat_jid = ?", chatJID)
+}
+
+// GetTotalMessageCount returns the total number of messages
+func (r *SQLiteRepository) GetTotalMessageCount() (int64, error) {
+	return r.getCount("SELECT COUNT(*) FROM messages")
+}
+
+// GetTotalChatCount returns the total number of chats
+func (r *SQLiteRepository) GetTotalChatCount() (int64, error) {
+	return r.getCount("SELECT COUNT(*) FROM chats")
+}
+
+// TruncateAllChats deletes all chats from the database
+// Note: Due to foreign key constraints, messages must be deleted first
+func (r *SQLiteRepository) TruncateAllChats() error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Delete poll votes and polls
+	_, err = tx.Exec("DELETE FROM poll_votes")
+	if err != nil {
+		return fmt.Errorf("failed to delete poll votes: %w", err)
+	}
+	_, err = tx.Exec("DELETE FROM polls")
+	if err != nil {
+		return fmt.Errorf("failed to delete polls: %w", err)
+	}
+
+	// Delete newsletter posts
+	_, err = tx.Exec("DELETE FROM newsletter_messages")
+	if err != nil {
+		return fmt.Errorf("failed to delete newsletter messages: %w", err)
+	}
+
+	// Delete group membership history
+	_, err = tx.Exec("DELETE FROM group_membership_events")
+	if err != nil {
+		return fmt.Errorf("failed to delete group membership events: %w", err)
+	}
+
+	// Delete message reactions
+	_, err = tx.Exec("DELETE FROM message_reactions")
+	if err != nil {
+		return fmt.Errorf("failed to delete message reactions: %w", err)
+	}
+
+	// Delete message receipts
+	_, err = tx.Exec("DELETE FROM message_receipts")
+	if err != nil {
+		return fmt.Errorf("failed to delete message receipts: %w", err)
+	}
+
+	// Delete the call log
+	_, err = tx.Exec("DELETE FROM calls")
+	if err != nil {
+		return fmt.Errorf("failed to delete calls: %w", err)
+	}
+
+	// Delete messages first (foreign key constraint)
+	_, err = tx.Exec("DELETE FROM messages")
+	if err != nil {
+		return fmt.Errorf("failed to delete messages: %w", err)
+	}
+
+	// Delete chats
+	_, err = tx.Exec("DELETE FROM chats")
+	if err != nil {
+		return fmt.Errorf("failed to delete chats: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetChatNameWithPushName determines the appropriate name for a chat with pushname support
+func (r *SQLiteRepository) GetChatNameWithPushName(jid types.JID, chatJID string, senderUser string, pushName string) string {
+	// First, check if chat already exists with a name
+	existingChat, err := r.GetChat(chatJID)
+	if err == nil && existingChat != nil && existingChat.Name != "" {
+		// If we have a pushname and the existing name is just a phone number/JID user, update it
+		if pushName != "" && (existingChat.Name == jid.User || existingChat.Name == senderUser) {
+			return pushName
+		}
+		return existingChat.Name
+	}
+
+	// Determine chat type and name
+	var name string
+
+	switch jid.Server {
+	case "g.us":
+		// This is a group chat
+		// For now, use a generic name - this can be enhanced later with group info
+		name = fmt.Sprintf("Group %s", jid.User)
+	case "newsletter":
+		// This is a newsletter/channel
+		name = fmt.Sprintf("Newsletter %s", jid.User)
+	default:
+		// This is an individual contact
+		// Priority: pushName > senderUser > JID user
+		if pushName != "" && pushName != senderUser && pushName != jid.User {
+			name = pushName
+		} else if senderUser != "" {
+			name = senderUser
+		} else {
+			name = jid.User
+		}
+	}
+
+	return name
+}
+
+func (r *SQLiteRepository) CreateMessage(ctx context.Context, evt *events.Message) error {
+	if evt == nil || evt.Message == nil {
+		return nil
+	}
+
+	// Extract chat and sender information
+	chatJID := evt.Info.Chat.String()
+	// Store the full sender JID (user@server) to ensure consistency between received and sent messages
+	sender := evt.Info.Sender.String()
+
+	// Get appropriate chat name using pushname if available
+	chatName := r.GetChatNameWithPushName(evt.Info.Chat, chatJID, evt.Info.Sender.User, evt.Info.PushName)
+
+	// Get existing chat to preserve ephemeral_expiration if needed
+	existingChat, err := r.GetChat(chatJID)
+	if err != nil {
+		return fmt.Errorf("failed to get existing chat: %w", err)
+	}
+
+	// Extract ephemeral expiration from incoming message
+	ephemeralExpiration := utils.ExtractEphemeralExpiration(evt.Message)
+
+	// Create or update chat
+	chat := &domainChatStorage.Chat{
+		JID:             chatJID,
+		Name:            chatName,
+		LastMessageTime: evt.Info.Timestamp,
+	}
+
+	// Set ephemeral expiration: use incoming message value if > 0, otherwise preserve existing
+	if ephemeralExpiration > 0 {
+		chat.EphemeralExpiration = ephemeralExpiration
+	} else if existingChat != nil {
+		// Preserve existing ephemeral_expiration if incoming message doesn't have one
+		chat.EphemeralExpiration = existingChat.EphemeralExpiration
+	}
+
+	// Store or update the chat
+	if err := r.StoreChat(chat); err != nil {
+		return fmt.Errorf("failed to store chat: %w", err)
+	}
+
+	// Extract message content and media info
+	content := utils.ExtractMessageTextFromProto(evt.Message)
+	mediaType, filename, url, mediaKey, fileSHA256, fileEncSHA256, fileLength := utils.ExtractMediaInfo(evt.Message)
+
+	// Skip if there's no content and no media
+	if content == "" && mediaType == "" {
+		logrus.Debugf("Skipping message %s - no content or media", evt.Info.ID)
+		return nil
+	}
+
+	// Create message object
+	message := &domainChatStorage.Message{
+		ID:            evt.Info.ID,
+		ChatJID:       chatJID,
+		Sender:        sender,
+		Content:       content,
+		Timestamp:     evt.Info.Timestamp,
+		IsFromMe:      evt.Info.IsFromMe,
+		MediaType:     mediaType,
+		Filename:      filename,
+		URL:           url,
+		MediaKey:      mediaKey,
+		FileSHA256:    fileSHA256,
+		FileEncSHA256: fileEncSHA256,
+		FileLength:    fileLength,
+	}
+
+	// Store the message
+	return r.StoreMessage(message)
+}
+
+// GetStorageStatistics returns current storage statistics for logging purposes
+func (r *SQLiteRepository) GetStorageStatistics() (chatCount int64, messageCount int64, err error) {
+	// Count all chats using efficient query
+	chatCount, err = r.GetTotalChatCount()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get chat count: %w", err)
+	}
+
+	// Count all messages
+	messageCount, err = r.GetTotalMessageCount()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get message count: %w", err)
+	}
+
+	return chatCount, messageCount, nil
+}
+
+// TruncateAllDataWithLogging performs truncation with detailed logging
+func (r *SQLiteRepository) TruncateAllDataWithLogging(logPrefix string) error {
+	// Get statistics before truncation
+	chatCount, messageCount, err := r.GetStorageStatistics()
+	if err != nil {
+		logrus.Warnf("[%s] Failed to get storage statistics before truncation: %v", logPrefix, err)
+	} else {
+		logrus.Infof("[%s] Storage before truncation: %d chats, %d messages", logPrefix, chatCount, messageCount)
+	}
+
+	// Perform truncation
+	if err := r.TruncateAllChats(); err != nil {
+		return fmt.Errorf("failed to truncate chatstorage data: %w", err)
+	}
+
+	// Verify truncation
+	chatCountAfter, messageCountAfter, err := r.GetStorageStatistics()
+	if err != nil {
+		logrus.Warnf("[%s] Failed to get storage statistics after truncation: %v", logPrefix, err)
+	} else {
+		logrus.Infof("[%s] Storage after truncation: %d chats, %d messages", logPrefix, chatCountAfter, messageCountAfter)
+		if chatCountAfter == 0 && messageCountAfter == 0 {
+			logrus.Infof("[%s] ✅ Chatstorage truncation completed successfully", logPrefix)
+		} else {
+			logrus.Warnf("[%s] ⚠️ Truncation may not have completed fully", logPrefix)
+		}
+	}
+
+	return nil
+}
+
+// StoreSentMessageWithContext stores a message that was sent by the user with context cancellation support
+func (r *SQLiteRepository) StoreSentMessageWithContext(ctx context.Context, messageID string, senderJID string, recipientJID string, content string, mediaType string, timestamp time.Time) error {
+	// Check if context is already cancelled before starting
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// Ensure JID is properly formatted
+	jid, err := types.ParseJID(recipientJID)
+	if err != nil {
+		return fmt.Errorf("invalid JID format: %w", err)
+	}
+
+	chatJID := jid.String()
+
+	// Get chat name (no pushname available for sent messages)
 	chatName := r.GetChatNameWithPushName(jid, chatJID, jid.User, "")
 
 	// Check context again before database operations
@@ -697,6 +1809,7 @@ func (r *SQLiteRepository) StoreSentMessageWithContext(ctx context.Context, mess
 		Content:   content,
 		Timestamp: timestamp,
 		IsFromMe:  true,
+		MediaType: mediaType,
 	}
 
 	return r.StoreMessage(message)
@@ -816,5 +1929,209 @@ func (r *SQLiteRepository) getMigrations() []string {
 		`
 		CREATE INDEX IF NOT EXISTS idx_messages_id ON messages(id);
 		`,
+
+		// Migration 3: Cache fetched link preview metadata
+		`
+		CREATE TABLE IF NOT EXISTS link_previews (
+			url TEXT PRIMARY KEY,
+			title TEXT,
+			description TEXT,
+			image_url TEXT,
+			image BLOB,
+			width INTEGER DEFAULT 0,
+			height INTEGER DEFAULT 0,
+			fetched_at TIMESTAMP NOT NULL
+		);
+		`,
+
+		// Migration 4: Polls with their decryption secret and the latest vote per voter
+		`
+		CREATE TABLE IF NOT EXISTS polls (
+			message_id TEXT PRIMARY KEY,
+			chat_jid TEXT NOT NULL,
+			creator TEXT NOT NULL,
+			question TEXT NOT NULL,
+			options TEXT NOT NULL,
+			max_answer INTEGER DEFAULT 0,
+			secret BLOB,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS poll_votes (
+			poll_message_id TEXT NOT NULL,
+			voter TEXT NOT NULL,
+			selected_options TEXT NOT NULL,
+			vote_message_id TEXT,
+			voted_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (poll_message_id, voter),
+			FOREIGN KEY (poll_message_id) REFERENCES polls(message_id) ON DELETE CASCADE
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_polls_chat_jid ON polls(chat_jid);
+		`,
+
+		// Migration 5: Named and versioned message templates
+		`
+		CREATE TABLE IF NOT EXISTS message_templates (
+			name TEXT NOT NULL,
+			version INTEGER NOT NULL,
+			body TEXT NOT NULL,
+			media_type TEXT NOT NULL DEFAULT '',
+			media_url TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (name, version)
+		);
+		`,
+
+		// Migration 6: Message edit history
+		`
+		ALTER TABLE messages ADD COLUMN edited_at TIMESTAMP;
+
+		CREATE TABLE IF NOT EXISTS message_edits (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			message_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			editor TEXT NOT NULL DEFAULT '',
+			previous_content TEXT NOT NULL DEFAULT '',
+			new_content TEXT NOT NULL DEFAULT '',
+			edited_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_message_edits_message ON message_edits(message_id, chat_jid);
+		`,
+
+		// Migration 7: Messages pinned inside chats
+		`
+		CREATE TABLE IF NOT EXISTS pinned_messages (
+			message_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			pinned_by TEXT NOT NULL DEFAULT '',
+			pinned_at TIMESTAMP NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (message_id, chat_jid)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_pinned_messages_chat ON pinned_messages(chat_jid, expires_at);
+		`,
+
+		// Migration 8: Newsletter posts with their server IDs and engagement counts
+		`
+		CREATE TABLE IF NOT EXISTS newsletter_messages (
+			newsletter_jid TEXT NOT NULL,
+			server_id INTEGER NOT NULL,
+			message_id TEXT NOT NULL DEFAULT '',
+			type TEXT NOT NULL DEFAULT '',
+			content TEXT NOT NULL DEFAULT '',
+			media_type TEXT NOT NULL DEFAULT '',
+			timestamp TIMESTAMP NOT NULL,
+			views_count INTEGER NOT NULL DEFAULT 0,
+			reaction_counts TEXT NOT NULL DEFAULT '{}',
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (newsletter_jid, server_id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_newsletter_messages_timestamp ON newsletter_messages(newsletter_jid, timestamp);
+		`,
+
+		// Migration 9: Group membership history (joins, leaves, role changes and snapshots)
+		`
+		CREATE TABLE IF NOT EXISTS group_membership_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			group_jid TEXT NOT NULL,
+			participant_jid TEXT NOT NULL,
+			action TEXT NOT NULL,
+			actor_jid TEXT NOT NULL DEFAULT '',
+			is_admin BOOLEAN NOT NULL DEFAULT FALSE,
+			timestamp TIMESTAMP NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (group_jid, participant_jid, action, timestamp)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_group_membership_events_group ON group_membership_events(group_jid, timestamp);
+		`,
+
+		// Migration 10: Cache of WhatsApp registration checks by phone number
+		`
+		CREATE TABLE IF NOT EXISTS whatsapp_checks (
+			phone TEXT PRIMARY KEY,
+			jid TEXT NOT NULL DEFAULT '',
+			is_on_whatsapp BOOLEAN NOT NULL DEFAULT FALSE,
+			is_business BOOLEAN NOT NULL DEFAULT FALSE,
+			verified_name TEXT NOT NULL DEFAULT '',
+			checked_at TIMESTAMP NOT NULL
+		);
+		`,
+
+		// Migration 11: Last known presence and typing state of contacts
+		`
+		CREATE TABLE IF NOT EXISTS presence (
+			jid TEXT PRIMARY KEY,
+			subscribed BOOLEAN NOT NULL DEFAULT FALSE,
+			is_online BOOLEAN NOT NULL DEFAULT FALSE,
+			last_seen TIMESTAMP,
+			chat_state TEXT NOT NULL DEFAULT '',
+			chat_state_chat TEXT NOT NULL DEFAULT '',
+			chat_state_at TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL
+		);
+		`,
+
+		// Migration 12: Log of incoming calls
+		`
+		CREATE TABLE IF NOT EXISTS calls (
+			id TEXT PRIMARY KEY,
+			caller TEXT NOT NULL,
+			group_jid TEXT NOT NULL DEFAULT '',
+			is_group BOOLEAN NOT NULL DEFAULT FALSE,
+			is_video BOOLEAN NOT NULL DEFAULT FALSE,
+			status TEXT NOT NULL,
+			auto_rejected BOOLEAN NOT NULL DEFAULT FALSE,
+			reason TEXT NOT NULL DEFAULT '',
+			started_at TIMESTAMP NOT NULL,
+			ended_at TIMESTAMP
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_calls_caller ON calls(caller, started_at);
+		CREATE INDEX IF NOT EXISTS idx_calls_started_at ON calls(started_at);
+		`,
+
+		// Migration 13: Delivery and read receipts of outgoing messages
+		`
+		CREATE TABLE IF NOT EXISTS message_receipts (
+			message_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			participant TEXT NOT NULL,
+			delivered_at TIMESTAMP,
+			read_at TIMESTAMP,
+			played_at TIMESTAMP,
+			PRIMARY KEY (message_id, chat_jid, participant)
+		);
+		`,
+
+		// Migration 14: Current reaction of every person to a message
+		`
+		CREATE TABLE IF NOT EXISTS message_reactions (
+			message_id TEXT NOT NULL,
+			chat_jid TEXT NOT NULL,
+			reactor TEXT NOT NULL,
+			emoji TEXT NOT NULL,
+			reacted_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (message_id, chat_jid, reactor)
+		);
+		`,
+
+		// Migration 15: Account wide settings that WhatsApp does not report back
+		`
+		CREATE TABLE IF NOT EXISTS account_settings (
+			name TEXT PRIMARY KEY,
+			value TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		);
+		`,
+
+		// Migration 16: Read watermark of chats, so messages are only marked as read once
+		`
+		ALTER TABLE chats ADD COLUMN last_read_at TIMESTAMP;
+		`,
 	}
 }
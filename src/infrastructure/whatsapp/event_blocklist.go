@@ -0,0 +1,138 @@
+package whatsapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+var (
+	blockedJIDs   = make(map[string]struct{})
+	blockedJIDsMu sync.RWMutex
+)
+
+// SetBlocklist replaces the cached block list, e.g. with the list returned after blocking or unblocking a contact
+func SetBlocklist(list *types.Blocklist) {
+	if list == nil {
+		return
+	}
+
+	blocked := make(map[string]struct{}, len(list.JIDs))
+	for _, jid := range list.JIDs {
+		blocked[jid.ToNonAD().String()] = struct{}{}
+	}
+
+	blockedJIDsMu.Lock()
+	blockedJIDs = blocked
+	blockedJIDsMu.Unlock()
+}
+
+// IsBlockedSender reports whether the sender of a message is on the cached block list, by phone number or LID
+func IsBlockedSender(source types.MessageSource) bool {
+	blockedJIDsMu.RLock()
+	defer blockedJIDsMu.RUnlock()
+
+	for _, jid := range []types.JID{source.Sender, source.SenderAlt} {
+		if jid.IsEmpty() {
+			continue
+		}
+		if _, ok := blockedJIDs[jid.ToNonAD().String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// handleBlocklistConnected loads the block list once the connection is established
+func handleBlocklistConnected() {
+	go refreshBlocklist()
+}
+
+// handleBlocklist applies block list changes made from any device and forwards them to the webhooks
+func handleBlocklist(ctx context.Context, evt *events.Blocklist) {
+	if evt.Action == events.BlocklistActionModify {
+		// The server only tells us the list changed, so fetch it again
+		refreshBlocklist()
+	} else {
+		blockedJIDsMu.Lock()
+		for _, change := range evt.Changes {
+			key := change.JID.ToNonAD().String()
+			switch change.Action {
+			case events.BlocklistChangeActionBlock:
+				blockedJIDs[key] = struct{}{}
+			case events.BlocklistChangeActionUnblock:
+				delete(blockedJIDs, key)
+			}
+		}
+		blockedJIDsMu.Unlock()
+	}
+
+	for _, change := range evt.Changes {
+		logrus.Infof("Blocklist: %s %sed", change.JID, change.Action)
+	}
+
+	if len(config.WhatsappWebhook) > 0 {
+		go func(e *events.Blocklist) {
+			if err := forwardBlocklistToWebhook(ctx, e); err != nil {
+				logrus.Errorf("Failed to forward blocklist event to webhook: %v", err)
+			}
+		}(evt)
+	}
+}
+
+// refreshBlocklist fetches the full block list from the server into the cache
+func refreshBlocklist() {
+	client := GetClient()
+	if client == nil || !client.IsLoggedIn() {
+		return
+	}
+
+	list, err := client.GetBlocklist()
+	if err != nil {
+		logrus.Warnf("Failed to fetch blocklist: %v", err)
+		return
+	}
+
+	SetBlocklist(list)
+	logrus.Infof("Loaded blocklist with %d contacts", len(list.JIDs))
+}
+
+// createBlocklistPayload creates a webhook payload for block list changes
+func createBlocklistPayload(evt *events.Blocklist) map[string]any {
+	changes := make([]map[string]any, 0, len(evt.Changes))
+	for _, change := range evt.Changes {
+		changes = append(changes, map[string]any{
+			"jid":    change.JID.ToNonAD().String(),
+			"action": string(change.Action),
+		})
+	}
+
+	action := string(evt.Action)
+	if action == "" {
+		action = "update"
+	}
+
+	return map[string]any{
+		"event":     "blocklist.changed",
+		"timestamp": time.Now().Format(time.RFC3339),
+		"payload": map[string]any{
+			"action":  action,
+			"changes": changes,
+		},
+	}
+}
+
+// forwardBlocklistToWebhook forwards block list changes to the configured webhook URLs
+func forwardBlocklistToWebhook(ctx context.Context, evt *events.Blocklist) error {
+	if err := submitEventWebhook(ctx, createBlocklistPayload(evt), "blocklist"); err != nil {
+		return err
+	}
+
+	logrus.Infof("Blocklist event forwarded to webhook with %d change(s)", len(evt.Changes))
+	return nil
+}
@@ -0,0 +1,332 @@
+package whatsapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+)
+
+// Values of config.WhatsappCallRejectPolicy
+const (
+	CallRejectOff                  = "off"
+	CallRejectAlways               = "always"
+	CallRejectOutsideBusinessHours = "outside_business_hours"
+)
+
+// ownBusinessProfileTTL is how long the cached business hours are trusted. WhatsApp sends no event when the
+// business profile is edited from the phone, so the profile is fetched again once it gets older than this.
+const ownBusinessProfileTTL = 15 * time.Minute
+
+var (
+	ownBusinessProfile   *types.BusinessProfile
+	ownBusinessProfileAt time.Time
+	ownBusinessProfileMu sync.RWMutex
+)
+
+// setOwnBusinessProfile replaces the cached business profile of the account, whose hours decide whether calls
+// are rejected under the outside_business_hours policy
+func setOwnBusinessProfile(profile *types.BusinessProfile) {
+	ownBusinessProfileMu.Lock()
+	ownBusinessProfile = profile
+	ownBusinessProfileAt = time.Now()
+	ownBusinessProfileMu.Unlock()
+}
+
+// handleCallConnected loads the business hours once the connection is established, so the first call does
+// not wait for them
+func handleCallConnected() {
+	if config.WhatsappCallRejectPolicy != CallRejectOutsideBusinessHours {
+		return
+	}
+
+	go refreshOwnBusinessProfile()
+}
+
+// getOwnBusinessProfile returns the cached business profile, fetching it again when it is older than
+// ownBusinessProfileTTL. The stale profile is kept when the fetch fails.
+func getOwnBusinessProfile() *types.BusinessProfile {
+	ownBusinessProfileMu.RLock()
+	profile, fetchedAt := ownBusinessProfile, ownBusinessProfileAt
+	ownBusinessProfileMu.RUnlock()
+
+	if profile != nil && time.Since(fetchedAt) < ownBusinessProfileTTL {
+		return profile
+	}
+	if fresh := refreshOwnBusinessProfile(); fresh != nil {
+		return fresh
+	}
+	return profile
+}
+
+func refreshOwnBusinessProfile() *types.BusinessProfile {
+	client := GetClient()
+	if client == nil || !client.IsLoggedIn() || client.Store.ID == nil {
+		return nil
+	}
+
+	profile, err := client.GetBusinessProfile(client.Store.ID.ToNonAD())
+	if err != nil {
+		logrus.Warnf("Failed to load business hours: %v", err)
+		return nil
+	}
+	if len(profile.BusinessHours) == 0 {
+		logrus.Warn("The business profile has no business hours, calls will not be rejected")
+	}
+	setOwnBusinessProfile(profile)
+	return profile
+}
+
+// handleCallOffer logs an incoming 1:1 call and rejects it when the reject policy applies
+func handleCallOffer(ctx context.Context, evt *events.CallOffer, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	isVideo := false
+	if evt.Data != nil {
+		_, isVideo = evt.Data.GetOptionalChildByTag("video")
+	}
+	handleIncomingCall(ctx, evt.BasicCallMeta, !evt.GroupJID.IsEmpty(), isVideo, chatStorageRepo)
+}
+
+// handleCallOfferNotice logs an incoming group call and rejects it when the reject policy applies
+func handleCallOfferNotice(ctx context.Context, evt *events.CallOfferNotice, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	isGroup := evt.Type == "group" || !evt.GroupJID.IsEmpty()
+	handleIncomingCall(ctx, evt.BasicCallMeta, isGroup, evt.Media == "video", chatStorageRepo)
+}
+
+func handleIncomingCall(ctx context.Context, meta types.BasicCallMeta, isGroup, isVideo bool, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	creator := callCreator(meta)
+	call := &domainChatStorage.Call{
+		ID:        meta.CallID,
+		Caller:    phoneNumberJID(ctx, creator).String(),
+		IsGroup:   isGroup,
+		IsVideo:   isVideo,
+		Status:    domainChatStorage.CallStatusRinging,
+		StartedAt: callTime(meta),
+	}
+	if !meta.GroupJID.IsEmpty() {
+		call.GroupJID = meta.GroupJID.String()
+	}
+	log.Infof("Incoming %s call %s from %s", callKind(call), call.ID, call.Caller)
+
+	if shouldRejectCall(time.Now()) && !IsBlockedSender(types.MessageSource{Sender: creator}) {
+		if err := cli.RejectCall(creator, call.ID); err != nil {
+			logrus.Errorf("Failed to reject call %s from %s: %v", call.ID, call.Caller, err)
+		} else {
+			endedAt := time.Now()
+			call.Status = domainChatStorage.CallStatusRejected
+			call.AutoRejected = true
+			call.EndedAt = &endedAt
+			logrus.Infof("Rejected call %s from %s", call.ID, call.Caller)
+		}
+	}
+
+	if chatStorageRepo != nil {
+		if err := chatStorageRepo.StoreCall(call); err != nil {
+			logrus.Errorf("Failed to store call %s: %v", call.ID, err)
+		}
+	}
+
+	if call.AutoRejected {
+		sendCallRejectMessage(ctx, call, chatStorageRepo)
+	}
+
+	event := "call.offer"
+	if call.AutoRejected {
+		event = "call.rejected"
+	}
+	forwardCallToWebhook(ctx, event, call)
+}
+
+// handleCallAccept marks a call as answered on one of the account's devices
+func handleCallAccept(ctx context.Context, evt *events.CallAccept, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	call := updateCall(ctx, evt.BasicCallMeta, chatStorageRepo, func(call *domainChatStorage.Call) {
+		call.Status = domainChatStorage.CallStatusAccepted
+	})
+	forwardCallToWebhook(ctx, "call.accepted", call)
+}
+
+// handleCallReject marks a call as declined on one of the account's devices
+func handleCallReject(ctx context.Context, evt *events.CallReject, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	call := updateCall(ctx, evt.BasicCallMeta, chatStorageRepo, func(call *domainChatStorage.Call) {
+		endedAt := callTime(evt.BasicCallMeta)
+		call.Status = domainChatStorage.CallStatusRejected
+		call.EndedAt = &endedAt
+	})
+	forwardCallToWebhook(ctx, "call.rejected", call)
+}
+
+// handleCallTerminate ends a call, a call that was never answered is logged as missed
+func handleCallTerminate(ctx context.Context, evt *events.CallTerminate, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	call := updateCall(ctx, evt.BasicCallMeta, chatStorageRepo, func(call *domainChatStorage.Call) {
+		switch call.Status {
+		case domainChatStorage.CallStatusRinging:
+			call.Status = domainChatStorage.CallStatusMissed
+		case domainChatStorage.CallStatusAccepted, "":
+			call.Status = domainChatStorage.CallStatusEnded
+		}
+		if call.EndedAt == nil {
+			endedAt := callTime(evt.BasicCallMeta)
+			call.EndedAt = &endedAt
+		}
+		call.Reason = evt.Reason
+	})
+	forwardCallToWebhook(ctx, "call.terminated", call)
+}
+
+// updateCall applies a change to a logged call and stores it. Calls that are not logged, such as calls placed
+// from the phone, are still returned so they can be forwarded to the webhooks.
+func updateCall(ctx context.Context, meta types.BasicCallMeta, chatStorageRepo domainChatStorage.IChatStorageRepository, apply func(call *domainChatStorage.Call)) *domainChatStorage.Call {
+	var call *domainChatStorage.Call
+	if chatStorageRepo != nil {
+		stored, err := chatStorageRepo.GetCall(meta.CallID)
+		if err != nil {
+			logrus.Errorf("Failed to get call %s: %v", meta.CallID, err)
+		}
+		call = stored
+	}
+
+	logged := call != nil
+	if !logged {
+		call = &domainChatStorage.Call{
+			ID:        meta.CallID,
+			Caller:    phoneNumberJID(ctx, callCreator(meta)).String(),
+			IsGroup:   !meta.GroupJID.IsEmpty(),
+			StartedAt: callTime(meta),
+		}
+		if !meta.GroupJID.IsEmpty() {
+			call.GroupJID = meta.GroupJID.String()
+		}
+	}
+
+	apply(call)
+	log.Infof("Call %s from %s is %s", call.ID, call.Caller, call.Status)
+
+	if logged {
+		if err := chatStorageRepo.UpdateCallStatus(call.ID, call.Status, call.Reason, call.EndedAt); err != nil {
+			logrus.Errorf("Failed to update call %s: %v", call.ID, err)
+		}
+	}
+	return call
+}
+
+// shouldRejectCall applies config.WhatsappCallRejectPolicy to a call arriving at now
+func shouldRejectCall(now time.Time) bool {
+	switch config.WhatsappCallRejectPolicy {
+	case CallRejectAlways:
+		return true
+	case CallRejectOutsideBusinessHours:
+		profile := getOwnBusinessProfile()
+
+		// Without known business hours nobody can tell whether the business is closed
+		if profile == nil {
+			return false
+		}
+		return !utils.IsWithinBusinessHours(now, profile.BusinessHoursTimeZone, profile.BusinessHours)
+	default:
+		return false
+	}
+}
+
+// sendCallRejectMessage tells the caller why the call was rejected, the message goes to the caller directly
+// even for group calls
+func sendCallRejectMessage(ctx context.Context, call *domainChatStorage.Call, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if config.WhatsappCallRejectMessage == "" {
+		return
+	}
+
+	recipient, err := types.ParseJID(call.Caller)
+	if err != nil {
+		logrus.Errorf("Failed to parse caller %s: %v", call.Caller, err)
+		return
+	}
+
+	response, err := cli.SendMessage(ctx, recipient, &waE2E.Message{Conversation: proto.String(config.WhatsappCallRejectMessage)})
+	if err != nil {
+		logrus.Errorf("Failed to send call reject message to %s: %v", call.Caller, err)
+		return
+	}
+
+	if chatStorageRepo != nil {
+		senderJID := ""
+		if cli.Store.ID != nil {
+			senderJID = cli.Store.ID.String()
+		}
+		if err := chatStorageRepo.StoreSentMessageWithContext(ctx, response.ID, senderJID, recipient.String(), config.WhatsappCallRejectMessage, "", response.Timestamp); err != nil {
+			logrus.Errorf("Failed to store call reject message in chat storage: %v", err)
+		}
+	}
+}
+
+// callCreator returns who started the call, older clients only set the sender
+func callCreator(meta types.BasicCallMeta) types.JID {
+	if !meta.CallCreator.IsEmpty() {
+		return meta.CallCreator
+	}
+	return meta.From
+}
+
+func callTime(meta types.BasicCallMeta) time.Time {
+	if meta.Timestamp.IsZero() {
+		return time.Now()
+	}
+	return meta.Timestamp
+}
+
+func callKind(call *domainChatStorage.Call) string {
+	kind := "voice"
+	if call.IsVideo {
+		kind = "video"
+	}
+	if call.IsGroup {
+		kind = "group " + kind
+	}
+	return kind
+}
+
+// forwardCallToWebhook forwards a call event to the configured webhook URLs
+func forwardCallToWebhook(ctx context.Context, event string, call *domainChatStorage.Call) {
+	if len(config.WhatsappWebhook) == 0 {
+		return
+	}
+
+	go func() {
+		if err := submitEventWebhook(ctx, createCallPayload(event, call), "call"); err != nil {
+			logrus.Errorf("Failed to forward %s event to webhook: %v", event, err)
+		}
+	}()
+}
+
+// createCallPayload creates a webhook payload for a call event
+func createCallPayload(event string, call *domainChatStorage.Call) map[string]any {
+	payload := map[string]any{
+		"call_id":       call.ID,
+		"from":          call.Caller,
+		"is_group":      call.IsGroup,
+		"is_video":      call.IsVideo,
+		"status":        call.Status,
+		"auto_rejected": call.AutoRejected,
+		"started_at":    call.StartedAt.Format(time.RFC3339),
+	}
+	if call.GroupJID != "" {
+		payload["group_jid"] = call.GroupJID
+	}
+	if call.Reason != "" {
+		payload["reason"] = call.Reason
+	}
+	if call.EndedAt != nil {
+		payload["ended_at"] = call.EndedAt.Format(time.RFC3339)
+	}
+
+	return map[string]any{
+		"event":     event,
+		"timestamp": time.Now().Format(time.RFC3339),
+		"payload":   payload,
+	}
+}
@@ -0,0 +1,27 @@
+package whatsapp
+
+import (
+	"sync"
+)
+
+var (
+	connectedHooksMu sync.RWMutex
+	connectedHooks   []func()
+)
+
+// OnConnected registers a hook that runs in the background every time the client connects,
+// including the reconnect that follows a QR or pairing code login
+func OnConnected(hook func()) {
+	connectedHooksMu.Lock()
+	defer connectedHooksMu.Unlock()
+	connectedHooks = append(connectedHooks, hook)
+}
+
+// handleConnectedHooks runs the hooks registered through OnConnected
+func handleConnectedHooks() {
+	connectedHooksMu.RLock()
+	defer connectedHooksMu.RUnlock()
+	for _, hook := range connectedHooks {
+		go hook()
+	}
+}
@@ -0,0 +1,43 @@
+package whatsapp
+
+import (
+	"time"
+
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// isMessageEdit reports whether a message only carries an edit of an earlier message
+func isMessageEdit(msg *waE2E.Message) bool {
+	return msg.GetProtocolMessage().GetType() == waE2E.ProtocolMessage_MESSAGE_EDIT
+}
+
+// handleMessageEdit applies text and caption edits made from any device to the stored message and records them
+func handleMessageEdit(evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil || !isMessageEdit(evt.Message) {
+		return
+	}
+
+	protocolMessage := evt.Message.GetProtocolMessage()
+	editedAt := evt.Info.Timestamp
+	if ts := protocolMessage.GetTimestampMS(); ts > 0 {
+		editedAt = time.UnixMilli(ts)
+	}
+
+	edit := &domainChatStorage.MessageEdit{
+		MessageID:  protocolMessage.GetKey().GetID(),
+		ChatJID:    evt.Info.Chat.String(),
+		Editor:     evt.Info.Sender.ToNonAD().String(),
+		NewContent: utils.ExtractMessageTextFromProto(protocolMessage.GetEditedMessage()),
+		EditedAt:   editedAt,
+	}
+
+	if err := chatStorageRepo.StoreMessageEdit(edit); err != nil {
+		log.Errorf("Failed to store edit of message %s: %v", edit.MessageID, err)
+		return
+	}
+
+	log.Infof("Message %s in %s was edited by %s", edit.MessageID, edit.ChatJID, edit.Editor)
+}
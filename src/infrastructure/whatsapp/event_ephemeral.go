@@ -0,0 +1,42 @@
+package whatsapp
+
+import (
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// handleEphemeralSetting keeps chats.ephemeral_expiration in sync when a private chat's disappearing timer is
+// changed from any device, including turning it off
+func handleEphemeralSetting(evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	protocolMessage := evt.Message.GetProtocolMessage()
+	if chatStorageRepo == nil || protocolMessage.GetType() != waE2E.ProtocolMessage_EPHEMERAL_SETTING {
+		return
+	}
+
+	updateChatEphemeralExpiration(evt.Info.Chat, protocolMessage.GetEphemeralExpiration(), chatStorageRepo)
+}
+
+// handleGroupEphemeral keeps chats.ephemeral_expiration in sync with the group's disappearing timer
+func handleGroupEphemeral(evt *events.GroupInfo, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil || evt.Ephemeral == nil {
+		return
+	}
+
+	var expiration uint32
+	if evt.Ephemeral.IsEphemeral {
+		expiration = evt.Ephemeral.DisappearingTimer
+	}
+
+	updateChatEphemeralExpiration(evt.JID, expiration, chatStorageRepo)
+}
+
+func updateChatEphemeralExpiration(chat types.JID, expiration uint32, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if err := chatStorageRepo.UpdateChatEphemeralExpiration(chat.String(), expiration); err != nil {
+		log.Errorf("Failed to update disappearing timer of %s: %v", chat, err)
+		return
+	}
+
+	log.Infof("Disappearing timer of %s set to %d seconds", chat, expiration)
+}
@@ -49,6 +49,142 @@ func jidsToStrings(jids []types.JID) []string {
 	return result
 }
 
+// createGroupUpdatedPayload creates a webhook payload describing group metadata changes and who made them
+func createGroupUpdatedPayload(evt *events.GroupInfo) map[string]any {
+	changes := make(map[string]any)
+
+	if evt.Name != nil {
+		changes["name"] = evt.Name.Name
+	}
+	if evt.Topic != nil {
+		changes["topic"] = map[string]any{
+			"topic":   evt.Topic.Topic,
+			"deleted": evt.Topic.TopicDeleted,
+		}
+	}
+	if evt.Locked != nil {
+		changes["locked"] = evt.Locked.IsLocked
+	}
+	if evt.Announce != nil {
+		changes["announce"] = evt.Announce.IsAnnounce
+	}
+	if evt.Ephemeral != nil {
+		var timer uint32
+		if evt.Ephemeral.IsEphemeral {
+			timer = evt.Ephemeral.DisappearingTimer
+		}
+		changes["ephemeral_timer"] = timer
+	}
+	if evt.MembershipApprovalMode != nil {
+		changes["join_approval"] = evt.MembershipApprovalMode.IsJoinApprovalRequired
+	}
+	if evt.NewInviteLink != nil {
+		changes["invite_link"] = *evt.NewInviteLink
+	}
+	if evt.Delete != nil {
+		changes["deleted"] = map[string]any{
+			"deleted": evt.Delete.Deleted,
+			"reason":  evt.Delete.DeleteReason,
+		}
+	}
+	if evt.Link != nil {
+		changes["linked"] = createGroupLinkChange(evt.Link)
+	}
+	if evt.Unlink != nil {
+		changes["unlinked"] = createGroupLinkChange(evt.Unlink)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return buildGroupUpdatedBody(evt.JID, evt.Sender, evt.SenderPN, changes, evt.Timestamp)
+}
+
+// createGroupPictureUpdatedPayload creates a webhook payload for a group picture change
+func createGroupPictureUpdatedPayload(evt *events.Picture) map[string]any {
+	changes := map[string]any{
+		"picture": map[string]any{
+			"picture_id": evt.PictureID,
+			"removed":    evt.Remove,
+		},
+	}
+
+	var actor *types.JID
+	if !evt.Author.IsEmpty() {
+		actor = &evt.Author
+	}
+
+	return buildGroupUpdatedBody(evt.JID, actor, nil, changes, evt.Timestamp)
+}
+
+// createGroupLinkChange flattens a community link change into a webhook-friendly map
+func createGroupLinkChange(change *types.GroupLinkChange) map[string]any {
+	return map[string]any{
+		"type":          string(change.Type),
+		"group_id":      change.Group.JID.String(),
+		"group_name":    change.Group.Name,
+		"unlink_reason": string(change.UnlinkReason),
+	}
+}
+
+// buildGroupUpdatedBody wraps group changes into the group.updated webhook envelope
+func buildGroupUpdatedBody(chat types.JID, actor, actorPN *types.JID, changes map[string]any, timestamp time.Time) map[string]any {
+	payload := map[string]any{
+		"chat_id": chat.String(),
+		"changes": changes,
+	}
+	if actor != nil {
+		payload["actor"] = actor.String()
+	}
+	if actorPN != nil {
+		payload["actor_pn"] = actorPN.String()
+	}
+
+	return map[string]any{
+		"event":     "group.updated",
+		"timestamp": timestamp.Format(time.RFC3339),
+		"payload":   payload,
+	}
+}
+
+// submitEventWebhook sends a payload to every configured webhook URL, failing only if all of them fail
+func submitEventWebhook(ctx context.Context, payload map[string]any, label string) error {
+	// Collect errors from all webhook URLs instead of failing fast
+	var errors []error
+	for _, url := range config.WhatsappWebhook {
+		if err := submitWebhook(ctx, payload, url); err != nil {
+			errors = append(errors, fmt.Errorf("webhook %s failed: %w", url, err))
+		}
+	}
+
+	// If all webhooks failed, return combined error
+	if len(errors) == len(config.WhatsappWebhook) && len(errors) > 0 {
+		var errMessages []string
+		for _, err := range errors {
+			errMessages = append(errMessages, err.Error())
+		}
+		return fmt.Errorf("all webhook URLs failed: %s", strings.Join(errMessages, "; "))
+	}
+
+	// Log partial failures
+	if len(errors) > 0 {
+		logrus.Warnf("Some webhook URLs failed for %s event: %v", label, errors)
+	}
+
+	return nil
+}
+
+// forwardGroupPictureToWebhook forwards group picture changes to the configured webhook URLs
+func forwardGroupPictureToWebhook(ctx context.Context, evt *events.Picture) error {
+	if err := submitEventWebhook(ctx, createGroupPictureUpdatedPayload(evt), "group picture"); err != nil {
+		return err
+	}
+
+	logrus.Infof("Group picture event forwarded to webhook: %s", evt.JID)
+	return nil
+}
+
 // forwardGroupInfoToWebhook forwards group information events to the configured webhook URLs
 func forwardGroupInfoToWebhook(ctx context.Context, evt *events.GroupInfo) error {
 	logrus.Infof("Forwarding group info event to %d configured webhook(s)", len(config.WhatsappWebhook))
@@ -67,32 +203,21 @@ func forwardGroupInfoToWebhook(ctx context.Context, evt *events.GroupInfo) error
 	for _, action := range actions {
 		if len(action.jids) > 0 {
 			payload := createGroupInfoPayload(evt, action.actionType, action.jids)
-
-			// Collect errors from all webhook URLs instead of failing fast
-			var errors []error
-			for _, url := range config.WhatsappWebhook {
-				if err := submitWebhook(ctx, payload, url); err != nil {
-					errors = append(errors, fmt.Errorf("webhook %s failed: %w", url, err))
-				}
-			}
-
-			// If all webhooks failed, return combined error
-			if len(errors) == len(config.WhatsappWebhook) && len(errors) > 0 {
-				var errMessages []string
-				for _, err := range errors {
-					errMessages = append(errMessages, err.Error())
-				}
-				return fmt.Errorf("all webhook URLs failed: %s", strings.Join(errMessages, "; "))
-			}
-
-			// Log partial failures
-			if len(errors) > 0 {
-				logrus.Warnf("Some webhook URLs failed for group %s event: %v", action.actionType, errors)
+			if err := submitEventWebhook(ctx, payload, "group "+action.actionType); err != nil {
+				return err
 			}
 
 			logrus.Infof("Group %s event forwarded to webhook: %d users %s", action.actionType, len(action.jids), action.actionType)
 		}
 	}
 
+	if payload := createGroupUpdatedPayload(evt); payload != nil {
+		if err := submitEventWebhook(ctx, payload, "group update"); err != nil {
+			return err
+		}
+
+		logrus.Infof("Group update event forwarded to webhook: %s", evt.JID)
+	}
+
 	return nil
 }
@@ -0,0 +1,212 @@
+package whatsapp
+
+import (
+	"slices"
+	"time"
+
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// handleGroupMembership records the participant changes of a group event, taking a full participant
+// snapshot first when the group has never been seen before
+func handleGroupMembership(evt *events.GroupInfo, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+	if len(evt.Join) == 0 && len(evt.Leave) == 0 && len(evt.Promote) == 0 && len(evt.Demote) == 0 {
+		return
+	}
+
+	go func() {
+		ensureGroupMembershipSnapshot(evt, chatStorageRepo)
+
+		if err := chatStorageRepo.StoreGroupMembershipEvents(groupMembershipEvents(evt)); err != nil {
+			logrus.Errorf("Failed to store membership events of group %s: %v", evt.JID, err)
+		}
+	}()
+}
+
+// handleJoinedGroup snapshots the participants of a group we were added to or rejoined, since any change made
+// while we were not a member was never delivered to us
+func handleJoinedGroup(evt *events.JoinedGroup, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+
+	storeGroupMembershipSnapshot(evt.JID, evt.Participants, time.Now(), chatStorageRepo)
+}
+
+// handleGroupMembershipConnected snapshots every joined group that has no membership history yet
+func handleGroupMembershipConnected(chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+
+	go func() {
+		client := GetClient()
+		if client == nil || !client.IsLoggedIn() {
+			return
+		}
+
+		groups, err := client.GetJoinedGroups()
+		if err != nil {
+			logrus.Warnf("Failed to list joined groups for membership snapshots: %v", err)
+			return
+		}
+
+		for _, group := range groups {
+			exists, err := chatStorageRepo.HasGroupMembershipEvents(group.JID.String())
+			if err != nil || exists {
+				continue
+			}
+			storeGroupMembershipSnapshot(group.JID, group.Participants, time.Now(), chatStorageRepo)
+		}
+	}()
+}
+
+// ensureGroupMembershipSnapshot stores the participants of a group that has no membership history as they were
+// just before the event. The participant list can only be fetched after the event, so its changes are undone
+// and the snapshot is dated before the event, letting the event itself be replayed on top of it.
+func ensureGroupMembershipSnapshot(evt *events.GroupInfo, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	exists, err := chatStorageRepo.HasGroupMembershipEvents(evt.JID.String())
+	if err != nil || exists {
+		return
+	}
+
+	client := GetClient()
+	if client == nil {
+		return
+	}
+
+	info, err := client.GetGroupInfo(evt.JID)
+	if err != nil {
+		logrus.Warnf("Failed to fetch participants of group %s for membership snapshot: %v", evt.JID, err)
+		return
+	}
+
+	// History is stored at second precision, so the snapshot goes a full second before the event
+	storeGroupMembershipSnapshot(info.JID, participantsBeforeEvent(info.Participants, evt), groupEventTime(evt).Add(-time.Second), chatStorageRepo)
+}
+
+// participantsBeforeEvent undoes the joins, leaves, promotions and demotions of a group event on a participant
+// list fetched after it
+func participantsBeforeEvent(participants []types.GroupParticipant, evt *events.GroupInfo) []types.GroupParticipant {
+	before := make([]types.GroupParticipant, 0, len(participants)+len(evt.Leave))
+	for _, participant := range participants {
+		if isGroupParticipantIn(participant, evt.Join) {
+			continue
+		}
+		if isGroupParticipantIn(participant, evt.Promote) {
+			participant.IsAdmin = false
+			participant.IsSuperAdmin = false
+		}
+		if isGroupParticipantIn(participant, evt.Demote) {
+			participant.IsAdmin = true
+		}
+		before = append(before, participant)
+	}
+
+	for _, jid := range evt.Leave {
+		stillListed := slices.ContainsFunc(before, func(participant types.GroupParticipant) bool {
+			return isGroupParticipantIn(participant, []types.JID{jid})
+		})
+		if !stillListed {
+			before = append(before, types.GroupParticipant{JID: jid})
+		}
+	}
+	return before
+}
+
+// isGroupParticipantIn reports whether a participant is one of jids, by phone number or LID
+func isGroupParticipantIn(participant types.GroupParticipant, jids []types.JID) bool {
+	return slices.ContainsFunc(jids, func(jid types.JID) bool {
+		for _, known := range []types.JID{participant.JID, participant.PhoneNumber, participant.LID} {
+			if !known.IsEmpty() && known.User == jid.User && known.Server == jid.Server {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// storeGroupMembershipSnapshot records the participants of a group as its members at the given time
+func storeGroupMembershipSnapshot(groupJID types.JID, participants []types.GroupParticipant, at time.Time, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	snapshot := make([]*domainChatStorage.GroupMembershipEvent, 0, len(participants))
+	for _, participant := range participants {
+		snapshot = append(snapshot, &domainChatStorage.GroupMembershipEvent{
+			GroupJID:       groupJID.String(),
+			ParticipantJID: participant.JID.String(),
+			Action:         domainChatStorage.GroupMembershipSnapshot,
+			IsAdmin:        participant.IsAdmin || participant.IsSuperAdmin,
+			Timestamp:      at,
+		})
+	}
+
+	if err := chatStorageRepo.StoreGroupMembershipEvents(snapshot); err != nil {
+		logrus.Errorf("Failed to store membership snapshot of group %s: %v", groupJID, err)
+		return
+	}
+
+	logrus.Infof("Stored membership snapshot of group %s with %d participants", groupJID, len(snapshot))
+}
+
+// groupEventTime returns when a group event happened, events without a timestamp are dated now
+func groupEventTime(evt *events.GroupInfo) time.Time {
+	if evt.Timestamp.IsZero() {
+		return time.Now()
+	}
+	return evt.Timestamp
+}
+
+// groupMembershipEvents converts the participant changes of a group event into membership history rows.
+// Joins and leaves performed by someone other than the participant are recorded as add and remove.
+func groupMembershipEvents(evt *events.GroupInfo) []*domainChatStorage.GroupMembershipEvent {
+	timestamp := groupEventTime(evt)
+
+	var actor string
+	if evt.Sender != nil {
+		actor = evt.Sender.String()
+	}
+
+	var result []*domainChatStorage.GroupMembershipEvent
+	appendEvents := func(jids []types.JID, action string) {
+		for _, jid := range jids {
+			result = append(result, &domainChatStorage.GroupMembershipEvent{
+				GroupJID:       evt.JID.String(),
+				ParticipantJID: jid.String(),
+				Action:         action,
+				ActorJID:       actor,
+				Timestamp:      timestamp,
+			})
+		}
+	}
+
+	if isGroupSelfAction(evt, evt.Join) {
+		appendEvents(evt.Join, domainChatStorage.GroupMembershipJoin)
+	} else {
+		appendEvents(evt.Join, domainChatStorage.GroupMembershipAdd)
+	}
+	if isGroupSelfAction(evt, evt.Leave) {
+		appendEvents(evt.Leave, domainChatStorage.GroupMembershipLeave)
+	} else {
+		appendEvents(evt.Leave, domainChatStorage.GroupMembershipRemove)
+	}
+	appendEvents(evt.Promote, domainChatStorage.GroupMembershipPromote)
+	appendEvents(evt.Demote, domainChatStorage.GroupMembershipDemote)
+
+	return result
+}
+
+// isGroupSelfAction reports whether the participants changed themselves, e.g. joined via invite link or left
+func isGroupSelfAction(evt *events.GroupInfo, jids []types.JID) bool {
+	if evt.JoinReason == "invite" || evt.Sender == nil {
+		return true
+	}
+
+	return slices.ContainsFunc(jids, func(jid types.JID) bool {
+		return jid.User == evt.Sender.User || (evt.SenderPN != nil && jid.User == evt.SenderPN.User)
+	})
+}
@@ -0,0 +1,37 @@
+package whatsapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestParticipantsBeforeEvent(t *testing.T) {
+	alice := types.NewJID("6289600000001", types.DefaultUserServer)
+	bob := types.NewJID("6289600000002", types.DefaultUserServer)
+	carol := types.NewJID("6289600000003", types.DefaultUserServer)
+	dave := types.NewJID("6289600000004", types.DefaultUserServer)
+
+	// Fetched after Bob joined, Carol left, Alice was promoted and Dave was demoted
+	after := []types.GroupParticipant{
+		{JID: alice, IsAdmin: true},
+		{JID: bob},
+		{JID: dave},
+	}
+	evt := &events.GroupInfo{
+		Join:    []types.JID{bob},
+		Leave:   []types.JID{carol},
+		Promote: []types.JID{alice},
+		Demote:  []types.JID{dave},
+	}
+
+	before := participantsBeforeEvent(after, evt)
+
+	assert.Equal(t, []types.GroupParticipant{
+		{JID: alice},
+		{JID: dave, IsAdmin: true},
+		{JID: carol},
+	}, before)
+}
@@ -142,12 +142,12 @@ func createMessagePayload(ctx context.Context, evt *events.Message) (map[string]
 			}
 		case "MESSAGE_EDIT":
 			body["action"] = "message_edited"
+			if key := protocolMessage.GetKey(); key != nil {
+				body["edited_message_id"] = key.GetID()
+			}
 			if editedMessage := protocolMessage.GetEditedMessage(); editedMessage != nil {
-				if editedText := editedMessage.GetExtendedTextMessage(); editedText != nil {
-					body["edited_text"] = editedText.GetText()
-				} else if editedConv := editedMessage.GetConversation(); editedConv != "" {
-					body["edited_text"] = editedConv
-				}
+				// Covers plain text as well as new captions of images, videos and documents
+				body["edited_text"] = utils.ExtractMessageTextFromProto(editedMessage)
 			}
 		}
 	}
@@ -183,6 +183,18 @@ func createMessagePayload(ctx context.Context, evt *events.Message) (map[string]
 		body["image"] = path
 	}
 
+	if groupInviteMessage := evt.Message.GetGroupInviteMessage(); groupInviteMessage != nil {
+		// Everything POST /group/invite/accept needs to join the group
+		body["group_invite"] = map[string]any{
+			"group_id":          groupInviteMessage.GetGroupJID(),
+			"group_name":        groupInviteMessage.GetGroupName(),
+			"inviter":           evt.Info.Sender.String(),
+			"invite_code":       groupInviteMessage.GetInviteCode(),
+			"invite_expiration": groupInviteMessage.GetInviteExpiration(),
+			"caption":           groupInviteMessage.GetCaption(),
+		}
+	}
+
 	if listMessage := evt.Message.GetListMessage(); listMessage != nil {
 		body["list"] = listMessage
 	}
@@ -227,6 +239,16 @@ func StoreMessage(ctx context.Context, evt *events.Message) error {
 		return fmt.Errorf("message event contains no message")
 	}
 
+	// Edits, pins and reactions change an earlier message and are tracked by their own handlers instead of being stored as new messages
+	if isMessageEdit(evt.Message) || evt.Message.GetPinInChatMessage() != nil || evt.Message.GetReactionMessage() != nil {
+		return nil
+	}
+
+	// Newsletter posts are keyed by server ID and kept in their own table
+	if evt.Info.Chat.Server == types.NewsletterServer {
+		return nil
+	}
+
 	// Extract relevant message information
 	messageID := evt.Info.ID
 	chatJID := evt.Info.Chat.String()
@@ -0,0 +1,223 @@
+package whatsapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// newsletterUpdatesBatch is how many posts are asked for when refreshing reaction and view counts
+const newsletterUpdatesBatch = 100
+
+var (
+	newsletterRefreshOnce sync.Once
+	newsletterLiveMu      sync.Mutex
+	newsletterLiveTimer   *time.Timer
+)
+
+// StoreNewsletterMessages saves newsletter posts fetched from the server
+func StoreNewsletterMessages(chatStorageRepo domainChatStorage.IChatStorageRepository, newsletterJID types.JID, messages []*types.NewsletterMessage) {
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+		if err := chatStorageRepo.StoreNewsletterMessage(buildNewsletterMessage(newsletterJID, message)); err != nil {
+			logrus.Errorf("Failed to store newsletter message %d of %s: %v", message.MessageServerID, newsletterJID, err)
+		}
+	}
+}
+
+// StoreSentNewsletterMessage saves a post published to one of our own newsletters
+func StoreSentNewsletterMessage(chatStorageRepo domainChatStorage.IChatStorageRepository, newsletterJID types.JID, resp whatsmeow.SendResponse, msg *waE2E.Message) {
+	if chatStorageRepo == nil || resp.ServerID == 0 {
+		return
+	}
+
+	content, mediaType := newsletterContent(msg)
+	messageType := "text"
+	if mediaType != "" && mediaType != "text" {
+		messageType = "media"
+	}
+
+	message := &domainChatStorage.NewsletterMessage{
+		NewsletterJID: newsletterJID.String(),
+		ServerID:      int64(resp.ServerID),
+		MessageID:     resp.ID,
+		Type:          messageType,
+		Content:       content,
+		MediaType:     mediaType,
+		Timestamp:     resp.Timestamp,
+	}
+	if err := chatStorageRepo.StoreNewsletterMessage(message); err != nil {
+		logrus.Errorf("Failed to store newsletter message %d of %s: %v", resp.ServerID, newsletterJID, err)
+	}
+}
+
+func buildNewsletterMessage(newsletterJID types.JID, message *types.NewsletterMessage) *domainChatStorage.NewsletterMessage {
+	content, mediaType := newsletterContent(message.Message)
+	return &domainChatStorage.NewsletterMessage{
+		NewsletterJID:  newsletterJID.String(),
+		ServerID:       int64(message.MessageServerID),
+		MessageID:      message.MessageID,
+		Type:           message.Type,
+		Content:        content,
+		MediaType:      mediaType,
+		Timestamp:      message.Timestamp,
+		ViewsCount:     message.ViewsCount,
+		ReactionCounts: message.ReactionCounts,
+	}
+}
+
+func newsletterContent(msg *waE2E.Message) (content string, mediaType string) {
+	if msg == nil {
+		return "", ""
+	}
+	content = utils.ExtractMessageTextFromProto(msg)
+	mediaType, _, _, _, _, _, _ = utils.ExtractMediaInfo(msg)
+	if mediaType == "" && content != "" {
+		mediaType = "text"
+	}
+	return content, mediaType
+}
+
+// handleNewsletterMessage stores posts delivered live by newsletters the account follows
+func handleNewsletterMessage(evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if evt.Info.Chat.Server != types.NewsletterServer || chatStorageRepo == nil || evt.Info.ServerID == 0 {
+		return
+	}
+
+	content, mediaType := newsletterContent(evt.Message)
+	message := &domainChatStorage.NewsletterMessage{
+		NewsletterJID: evt.Info.Chat.String(),
+		ServerID:      int64(evt.Info.ServerID),
+		MessageID:     evt.Info.ID,
+		Type:          evt.Info.Type,
+		Content:       content,
+		MediaType:     mediaType,
+		Timestamp:     evt.Info.Timestamp,
+	}
+	if err := chatStorageRepo.StoreNewsletterMessage(message); err != nil {
+		logrus.Errorf("Failed to store newsletter message %d of %s: %v", evt.Info.ServerID, evt.Info.Chat, err)
+	}
+}
+
+// handleNewsletterLiveUpdate applies reaction and view count changes pushed for subscribed newsletters
+func handleNewsletterLiveUpdate(evt *events.NewsletterLiveUpdate, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+	applyNewsletterCounts(chatStorageRepo, evt.JID, evt.Messages)
+}
+
+func applyNewsletterCounts(chatStorageRepo domainChatStorage.IChatStorageRepository, newsletterJID types.JID, messages []*types.NewsletterMessage) {
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+		if message.Message != nil {
+			// Updates that carry the post itself may be the first time we see it
+			if err := chatStorageRepo.StoreNewsletterMessage(buildNewsletterMessage(newsletterJID, message)); err != nil {
+				logrus.Errorf("Failed to store newsletter message %d of %s: %v", message.MessageServerID, newsletterJID, err)
+			}
+			continue
+		}
+		if err := chatStorageRepo.UpdateNewsletterMessageCounts(newsletterJID.String(), int64(message.MessageServerID), message.ViewsCount, message.ReactionCounts); err != nil {
+			logrus.Errorf("Failed to update counts of newsletter message %d of %s: %v", message.MessageServerID, newsletterJID, err)
+		}
+	}
+}
+
+// handleNewsletterConnected subscribes to live updates of followed newsletters and starts the periodic count refresh
+func handleNewsletterConnected(ctx context.Context, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+
+	go subscribeNewsletterLiveUpdates(ctx)
+
+	if config.WhatsappNewsletterRefreshInterval > 0 {
+		newsletterRefreshOnce.Do(func() {
+			go runNewsletterRefresh(chatStorageRepo)
+		})
+	}
+}
+
+// subscribeNewsletterLiveUpdates subscribes to every followed newsletter and renews before the shortest subscription expires
+func subscribeNewsletterLiveUpdates(ctx context.Context) {
+	client := GetClient()
+	if client == nil || !client.IsLoggedIn() {
+		return
+	}
+
+	newsletters, err := client.GetSubscribedNewsletters()
+	if err != nil {
+		logrus.Warnf("Failed to list followed newsletters: %v", err)
+		return
+	}
+
+	var renewIn time.Duration
+	for _, newsletter := range newsletters {
+		duration, err := client.NewsletterSubscribeLiveUpdates(ctx, newsletter.ID)
+		if err != nil {
+			logrus.Warnf("Failed to subscribe to live updates of %s: %v", newsletter.ID, err)
+			continue
+		}
+		if renewIn == 0 || duration < renewIn {
+			renewIn = duration
+		}
+	}
+	if renewIn <= 0 {
+		return
+	}
+
+	newsletterLiveMu.Lock()
+	defer newsletterLiveMu.Unlock()
+	if newsletterLiveTimer != nil {
+		newsletterLiveTimer.Stop()
+	}
+	newsletterLiveTimer = time.AfterFunc(renewIn, func() {
+		subscribeNewsletterLiveUpdates(context.Background())
+	})
+}
+
+func runNewsletterRefresh(chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	ticker := time.NewTicker(config.WhatsappNewsletterRefreshInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		client := GetClient()
+		if client == nil || !client.IsLoggedIn() {
+			continue
+		}
+		refreshNewsletterCounts(client, chatStorageRepo)
+	}
+}
+
+// refreshNewsletterCounts pulls the latest reaction and view counts of every followed newsletter
+func refreshNewsletterCounts(client *whatsmeow.Client, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	newsletters, err := client.GetSubscribedNewsletters()
+	if err != nil {
+		logrus.Warnf("Failed to list followed newsletters: %v", err)
+		return
+	}
+
+	for _, newsletter := range newsletters {
+		updates, err := client.GetNewsletterMessageUpdates(newsletter.ID, &whatsmeow.GetNewsletterUpdatesParams{
+			Count: newsletterUpdatesBatch,
+		})
+		if err != nil {
+			logrus.Warnf("Failed to refresh newsletter %s: %v", newsletter.ID, err)
+			continue
+		}
+		applyNewsletterCounts(chatStorageRepo, newsletter.ID, updates)
+	}
+}
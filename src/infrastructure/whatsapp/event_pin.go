@@ -0,0 +1,103 @@
+package whatsapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// defaultPinDuration is used when a pin does not say how long it lasts
+const defaultPinDuration = 7 * 24 * time.Hour
+
+// handlePinMessage tracks messages pinned or unpinned from any device and forwards the change to the webhook
+func handlePinMessage(ctx context.Context, evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	pinMessage := evt.Message.GetPinInChatMessage()
+	if pinMessage == nil || chatStorageRepo == nil {
+		return
+	}
+
+	pinnedAt := evt.Info.Timestamp
+	if ts := pinMessage.GetSenderTimestampMS(); ts > 0 {
+		pinnedAt = time.UnixMilli(ts)
+	}
+
+	duration := defaultPinDuration
+	if seconds := evt.Message.GetMessageContextInfo().GetMessageAddOnDurationInSecs(); seconds > 0 {
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	pin := &domainChatStorage.PinnedMessage{
+		MessageID: pinMessage.GetKey().GetID(),
+		ChatJID:   evt.Info.Chat.String(),
+		PinnedBy:  evt.Info.Sender.ToNonAD().String(),
+		PinnedAt:  pinnedAt,
+		ExpiresAt: pinnedAt.Add(duration),
+	}
+
+	isPinned := pinMessage.GetType() == waE2E.PinInChatMessage_PIN_FOR_ALL
+	var err error
+	if isPinned {
+		err = chatStorageRepo.StorePinnedMessage(pin)
+	} else {
+		err = chatStorageRepo.DeletePinnedMessage(pin.MessageID, pin.ChatJID)
+	}
+	if err != nil {
+		log.Errorf("Failed to store pin state of message %s: %v", pin.MessageID, err)
+		return
+	}
+
+	log.Infof("Message %s in %s pinned=%t by %s", pin.MessageID, pin.ChatJID, isPinned, pin.PinnedBy)
+
+	if len(config.WhatsappWebhook) > 0 {
+		go func() {
+			if err := forwardPinToWebhook(ctx, pin, isPinned); err != nil {
+				logrus.Errorf("Failed to forward pin event to webhook: %v", err)
+			}
+		}()
+	}
+}
+
+// createPinPayload creates a webhook payload for message pin events
+func createPinPayload(pin *domainChatStorage.PinnedMessage, isPinned bool) map[string]any {
+	body := make(map[string]any)
+
+	payload := make(map[string]any)
+	payload["chat_id"] = pin.ChatJID
+	payload["message_id"] = pin.MessageID
+	payload["by"] = pin.PinnedBy
+	if isPinned {
+		payload["action"] = "pin"
+		payload["expires_at"] = pin.ExpiresAt.Format(time.RFC3339)
+	} else {
+		payload["action"] = "unpin"
+	}
+
+	// Wrap in payload structure
+	body["payload"] = payload
+
+	// Add metadata for webhook processing
+	body["event"] = "message.pin"
+	body["timestamp"] = pin.PinnedAt.Format(time.RFC3339)
+
+	return body
+}
+
+// forwardPinToWebhook forwards message pin events to the configured webhook URLs
+func forwardPinToWebhook(ctx context.Context, pin *domainChatStorage.PinnedMessage, isPinned bool) error {
+	logrus.Infof("Forwarding pin event to %d configured webhook(s)", len(config.WhatsappWebhook))
+	payload := createPinPayload(pin, isPinned)
+
+	for _, url := range config.WhatsappWebhook {
+		if err := submitWebhook(ctx, payload, url); err != nil {
+			return err
+		}
+	}
+
+	logrus.Info("Pin event forwarded to webhook")
+	return nil
+}
@@ -0,0 +1,179 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// handlePollMessage records poll creations and decrypts incoming poll votes
+func handlePollMessage(ctx context.Context, evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+
+	if pollCreation := getPollCreationMessage(evt.Message); pollCreation != nil {
+		storePollCreation(evt, pollCreation, chatStorageRepo)
+		return
+	}
+
+	if evt.Message.GetPollUpdateMessage() != nil {
+		handlePollVote(ctx, evt, chatStorageRepo)
+	}
+}
+
+// getPollCreationMessage returns the poll creation content regardless of the poll message version
+func getPollCreationMessage(msg *waE2E.Message) *waE2E.PollCreationMessage {
+	if poll := msg.GetPollCreationMessage(); poll != nil {
+		return poll
+	}
+	if poll := msg.GetPollCreationMessageV2(); poll != nil {
+		return poll
+	}
+	if poll := msg.GetPollCreationMessageV3(); poll != nil {
+		return poll
+	}
+	return nil
+}
+
+// storePollCreation keeps the options and secret of a poll seen in an incoming or synced message
+func storePollCreation(evt *events.Message, pollCreation *waE2E.PollCreationMessage, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	options := make([]string, 0, len(pollCreation.GetOptions()))
+	for _, option := range pollCreation.GetOptions() {
+		options = append(options, option.GetOptionName())
+	}
+
+	poll := &domainChatStorage.Poll{
+		MessageID: evt.Info.ID,
+		ChatJID:   evt.Info.Chat.String(),
+		Creator:   evt.Info.Sender.ToNonAD().String(),
+		Question:  pollCreation.GetName(),
+		Options:   options,
+		MaxAnswer: int(pollCreation.GetSelectableOptionsCount()),
+		Secret:    evt.Message.GetMessageContextInfo().GetMessageSecret(),
+		CreatedAt: evt.Info.Timestamp,
+	}
+
+	if err := chatStorageRepo.StorePoll(poll); err != nil {
+		log.Errorf("Failed to store poll %s: %v", evt.Info.ID, err)
+	}
+}
+
+// handlePollVote decrypts a poll vote, stores it as the voter's latest vote and forwards it to the webhook
+func handlePollVote(ctx context.Context, evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	pollUpdate := evt.Message.GetPollUpdateMessage()
+	pollID := pollUpdate.GetPollCreationMessageKey().GetID()
+
+	poll, err := chatStorageRepo.GetPoll(pollID)
+	if err != nil {
+		log.Errorf("Failed to load poll %s: %v", pollID, err)
+		return
+	}
+	if poll == nil {
+		log.Debugf("Skipping vote %s for unknown poll %s", evt.Info.ID, pollID)
+		return
+	}
+
+	vote, err := cli.DecryptPollVote(ctx, evt)
+	if errors.Is(err, whatsmeow.ErrOriginalMessageSecretNotFound) && len(poll.Secret) > 0 {
+		// The session store lost the secret (e.g. after re-pairing), restore it from our own copy and retry
+		if creator, parseErr := types.ParseJID(poll.Creator); parseErr == nil {
+			if putErr := cli.Store.MsgSecrets.PutMessageSecret(ctx, evt.Info.Chat, creator, pollID, poll.Secret); putErr != nil {
+				log.Warnf("Failed to restore secret of poll %s: %v", pollID, putErr)
+			} else {
+				vote, err = cli.DecryptPollVote(ctx, evt)
+			}
+		}
+	}
+	if err != nil {
+		log.Errorf("Failed to decrypt vote %s for poll %s: %v", evt.Info.ID, pollID, err)
+		return
+	}
+
+	// Votes only carry SHA-256 hashes of the selected option names
+	optionsByHash := make(map[string]string, len(poll.Options))
+	for i, hash := range whatsmeow.HashPollOptions(poll.Options) {
+		optionsByHash[hex.EncodeToString(hash)] = poll.Options[i]
+	}
+
+	selected := make([]string, 0, len(vote.GetSelectedOptions()))
+	for _, hash := range vote.GetSelectedOptions() {
+		if option, ok := optionsByHash[hex.EncodeToString(hash)]; ok {
+			selected = append(selected, option)
+		}
+	}
+
+	votedAt := evt.Info.Timestamp
+	if ts := pollUpdate.GetSenderTimestampMS(); ts > 0 {
+		votedAt = time.UnixMilli(ts)
+	}
+
+	pollVote := &domainChatStorage.PollVote{
+		PollMessageID:   pollID,
+		Voter:           evt.Info.Sender.ToNonAD().String(),
+		SelectedOptions: selected,
+		VoteMessageID:   evt.Info.ID,
+		VotedAt:         votedAt,
+	}
+
+	if err := chatStorageRepo.StorePollVote(pollVote); err != nil {
+		log.Errorf("Failed to store vote %s for poll %s: %v", evt.Info.ID, pollID, err)
+		return
+	}
+
+	log.Infof("Poll %s: %s voted for %v", pollID, pollVote.Voter, selected)
+
+	if len(config.WhatsappWebhook) > 0 {
+		go func() {
+			if err := forwardPollVoteToWebhook(ctx, poll, pollVote); err != nil {
+				logrus.Errorf("Failed to forward poll vote event to webhook: %v", err)
+			}
+		}()
+	}
+}
+
+// createPollVotePayload creates a webhook payload for poll vote events
+func createPollVotePayload(poll *domainChatStorage.Poll, vote *domainChatStorage.PollVote) map[string]any {
+	body := make(map[string]any)
+
+	payload := make(map[string]any)
+	payload["chat_id"] = poll.ChatJID
+	payload["poll_id"] = poll.MessageID
+	payload["question"] = poll.Question
+	payload["voter"] = vote.Voter
+	payload["selected_options"] = vote.SelectedOptions
+	payload["vote_message_id"] = vote.VoteMessageID
+
+	// Wrap in payload structure
+	body["payload"] = payload
+
+	// Add metadata for webhook processing
+	body["event"] = "poll.vote"
+	body["timestamp"] = vote.VotedAt.Format(time.RFC3339)
+
+	return body
+}
+
+// forwardPollVoteToWebhook forwards poll vote events to the configured webhook URLs
+func forwardPollVoteToWebhook(ctx context.Context, poll *domainChatStorage.Poll, vote *domainChatStorage.PollVote) error {
+	logrus.Infof("Forwarding poll vote event to %d configured webhook(s)", len(config.WhatsappWebhook))
+	payload := createPollVotePayload(poll, vote)
+
+	for _, url := range config.WhatsappWebhook {
+		if err := submitWebhook(ctx, payload, url); err != nil {
+			return err
+		}
+	}
+
+	logrus.Info("Poll vote event forwarded to webhook")
+	return nil
+}
@@ -0,0 +1,167 @@
+package whatsapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+const chatStateRecording = "recording"
+
+// handlePresenceConnected subscribes again to the presence of stored contacts, since subscriptions do not outlive a connection
+func handlePresenceConnected(chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil {
+		return
+	}
+
+	go func() {
+		client := GetClient()
+		if client == nil || !client.IsLoggedIn() {
+			return
+		}
+
+		jids, err := chatStorageRepo.GetPresenceSubscriptions()
+		if err != nil {
+			logrus.Warnf("Failed to load presence subscriptions: %v", err)
+			return
+		}
+
+		// The server only sends presence updates to clients that are online themselves
+		if len(jids) > 0 {
+			if err := client.SendPresence(types.PresenceAvailable); err != nil {
+				logrus.Warnf("Failed to send available presence before subscribing: %v", err)
+			}
+		}
+
+		for _, raw := range jids {
+			jid, err := types.ParseJID(raw)
+			if err != nil {
+				continue
+			}
+			if err := client.SubscribePresence(jid); err != nil {
+				logrus.Warnf("Failed to subscribe to presence of %s: %v", jid, err)
+			}
+		}
+		if len(jids) > 0 {
+			logrus.Infof("Subscribed to presence of %d contacts", len(jids))
+		}
+	}()
+}
+
+// handlePresence stores the online state of a subscribed contact and forwards it to the webhooks
+func handlePresence(ctx context.Context, evt *events.Presence, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if evt.Unavailable {
+		if evt.LastSeen.IsZero() {
+			log.Infof("%s is now offline", evt.From)
+		} else {
+			log.Infof("%s is now offline (last seen: %s)", evt.From, evt.LastSeen)
+		}
+	} else {
+		log.Infof("%s is now online", evt.From)
+	}
+
+	jid := phoneNumberJID(ctx, evt.From)
+	if chatStorageRepo != nil {
+		if err := chatStorageRepo.StorePresence(jid.String(), !evt.Unavailable, evt.LastSeen); err != nil {
+			logrus.Errorf("Failed to store presence of %s: %v", jid, err)
+		}
+
+		// Updates keep arriving after an unsubscribe until the connection is renewed
+		if presence, err := chatStorageRepo.GetPresence(jid.String()); err == nil && presence != nil && !presence.Subscribed {
+			return
+		}
+	}
+
+	if len(config.WhatsappWebhook) > 0 {
+		go func(e *events.Presence) {
+			if err := submitEventWebhook(ctx, createPresencePayload(jid, e), "presence"); err != nil {
+				logrus.Errorf("Failed to forward presence event to webhook: %v", err)
+			}
+		}(evt)
+	}
+}
+
+// handleChatPresence stores whether a contact is typing or recording and forwards it to the webhooks
+func handleChatPresence(ctx context.Context, evt *events.ChatPresence, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if evt.IsFromMe {
+		return
+	}
+
+	jid := phoneNumberJID(ctx, evt.Sender)
+	state := chatState(evt)
+	log.Debugf("%s is %s in %s", jid, state, evt.Chat)
+
+	if chatStorageRepo != nil {
+		if err := chatStorageRepo.StoreChatState(jid.String(), evt.Chat.String(), state); err != nil {
+			logrus.Errorf("Failed to store chat state of %s: %v", jid, err)
+		}
+	}
+
+	if len(config.WhatsappWebhook) > 0 {
+		go func(e *events.ChatPresence) {
+			if err := submitEventWebhook(ctx, createChatPresencePayload(jid, state, e), "chat presence"); err != nil {
+				logrus.Errorf("Failed to forward chat presence event to webhook: %v", err)
+			}
+		}(evt)
+	}
+}
+
+// phoneNumberJID returns the phone number JID of a contact when WhatsApp reports its LID
+func phoneNumberJID(ctx context.Context, jid types.JID) types.JID {
+	jid = jid.ToNonAD()
+	if jid.Server != types.HiddenUserServer || cli == nil {
+		return jid
+	}
+
+	pn, err := cli.Store.LIDs.GetPNForLID(ctx, jid)
+	if err != nil {
+		logrus.Debugf("Failed to get pn for lid %s: %v", jid, err)
+	}
+	if pn.IsEmpty() {
+		return jid
+	}
+	return pn
+}
+
+// chatState returns composing, recording or paused
+func chatState(evt *events.ChatPresence) string {
+	if evt.State == types.ChatPresenceComposing && evt.Media == types.ChatPresenceMediaAudio {
+		return chatStateRecording
+	}
+	return string(evt.State)
+}
+
+// createPresencePayload creates a webhook payload for an online state change
+func createPresencePayload(jid types.JID, evt *events.Presence) map[string]any {
+	payload := map[string]any{
+		"jid":       jid.String(),
+		"is_online": !evt.Unavailable,
+	}
+	if !evt.LastSeen.IsZero() {
+		payload["last_seen"] = evt.LastSeen.Format(time.RFC3339)
+	}
+
+	return map[string]any{
+		"event":     "presence.updated",
+		"timestamp": time.Now().Format(time.RFC3339),
+		"payload":   payload,
+	}
+}
+
+// createChatPresencePayload creates a webhook payload for a typing state change
+func createChatPresencePayload(jid types.JID, state string, evt *events.ChatPresence) map[string]any {
+	return map[string]any{
+		"event":     "chat_presence.updated",
+		"timestamp": time.Now().Format(time.RFC3339),
+		"payload": map[string]any{
+			"jid":      jid.String(),
+			"chat_jid": evt.Chat.String(),
+			"state":    state,
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package whatsapp
+
+import (
+	"context"
+	"time"
+
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// handleReactionMessage applies reactions set, changed or removed from any device to the stored reactions.
+// Reactions reach the webhook with the message itself.
+func handleReactionMessage(ctx context.Context, evt *events.Message, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	reactionMessage := evt.Message.GetReactionMessage()
+	if reactionMessage == nil || chatStorageRepo == nil {
+		return
+	}
+
+	reactedAt := evt.Info.Timestamp
+	if ts := reactionMessage.GetSenderTimestampMS(); ts > 0 {
+		reactedAt = time.UnixMilli(ts)
+	}
+
+	reaction := &domainChatStorage.MessageReaction{
+		MessageID: reactionMessage.GetKey().GetID(),
+		ChatJID:   evt.Info.Chat.String(),
+		Reactor:   phoneNumberJID(ctx, evt.Info.Sender).String(),
+		Emoji:     reactionMessage.GetText(),
+		ReactedAt: reactedAt,
+	}
+
+	// An empty reaction takes the earlier one back
+	if reaction.Emoji == "" {
+		if err := chatStorageRepo.DeleteMessageReaction(reaction.MessageID, reaction.ChatJID, reaction.Reactor, reaction.ReactedAt); err != nil {
+			log.Errorf("Failed to remove reaction of %s to message %s: %v", reaction.Reactor, reaction.MessageID, err)
+			return
+		}
+		log.Infof("%s removed their reaction to message %s in %s", reaction.Reactor, reaction.MessageID, reaction.ChatJID)
+		return
+	}
+
+	if err := chatStorageRepo.StoreMessageReaction(reaction); err != nil {
+		log.Errorf("Failed to store reaction of %s to message %s: %v", reaction.Reactor, reaction.MessageID, err)
+		return
+	}
+	log.Infof("%s reacted %s to message %s in %s", reaction.Reactor, reaction.Emoji, reaction.MessageID, reaction.ChatJID)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	"github.com/sirupsen/logrus"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
@@ -34,6 +35,44 @@ func getReceiptTypeDescription(evt types.ReceiptType) string {
 	}
 }
 
+// receiptStatus returns the message status a recipient confirms with a receipt, or an empty string for receipts
+// that do not describe a recipient
+func receiptStatus(receiptType types.ReceiptType) string {
+	switch receiptType {
+	case types.ReceiptTypeDelivered:
+		return domainChatStorage.MessageStatusDelivered
+	case types.ReceiptTypeRead:
+		return domainChatStorage.MessageStatusRead
+	case types.ReceiptTypePlayed:
+		return domainChatStorage.MessageStatusPlayed
+	default:
+		return ""
+	}
+}
+
+// storeReceipt records how far our messages got with the recipient that sent the receipt
+func storeReceipt(ctx context.Context, evt *events.Receipt, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil || evt.IsFromMe {
+		return
+	}
+
+	status := receiptStatus(evt.Type)
+	if status == "" {
+		return
+	}
+
+	// Sent messages are stored under the phone number of a contact, even when WhatsApp addresses the receipt by LID
+	chatJID := evt.Chat
+	if !evt.IsGroup {
+		chatJID = phoneNumberJID(ctx, evt.Chat)
+	}
+	participant := phoneNumberJID(ctx, evt.Sender)
+
+	if err := chatStorageRepo.StoreMessageReceipts(chatJID.String(), participant.String(), status, evt.MessageIDs, evt.Timestamp); err != nil {
+		logrus.Errorf("Failed to store %s receipt from %s: %v", status, participant, err)
+	}
+}
+
 // createReceiptPayload creates a webhook payload for message acknowledgement (receipt) events
 func createReceiptPayload(evt *events.Receipt) map[string]any {
 	body := make(map[string]any)
@@ -354,22 +354,50 @@ func handler(ctx context.Context, rawEvt any, chatStorageRepo domainChatStorage.
 		handlePairSuccess(ctx, evt)
 	case *events.LoggedOut:
 		handleLoggedOut(ctx, chatStorageRepo)
-	case *events.Connected, *events.PushNameSetting:
+	case *events.Connected:
+		handleConnectionEvents(ctx)
+		handleNewsletterConnected(ctx, chatStorageRepo)
+		handleGroupMembershipConnected(chatStorageRepo)
+		handleBlocklistConnected()
+		handlePresenceConnected(chatStorageRepo)
+		handleCallConnected()
+		handleConnectedHooks()
+	case *events.PushNameSetting:
 		handleConnectionEvents(ctx)
 	case *events.StreamReplaced:
 		handleStreamReplaced(ctx)
 	case *events.Message:
 		handleMessage(ctx, evt, chatStorageRepo)
 	case *events.Receipt:
-		handleReceipt(ctx, evt)
+		handleReceipt(ctx, evt, chatStorageRepo)
 	case *events.Presence:
-		handlePresence(ctx, evt)
+		handlePresence(ctx, evt, chatStorageRepo)
+	case *events.ChatPresence:
+		handleChatPresence(ctx, evt, chatStorageRepo)
 	case *events.HistorySync:
 		handleHistorySync(ctx, evt, chatStorageRepo)
 	case *events.AppState:
 		handleAppState(ctx, evt)
 	case *events.GroupInfo:
-		handleGroupInfo(ctx, evt)
+		handleGroupInfo(ctx, evt, chatStorageRepo)
+	case *events.JoinedGroup:
+		handleJoinedGroup(evt, chatStorageRepo)
+	case *events.NewsletterLiveUpdate:
+		handleNewsletterLiveUpdate(evt, chatStorageRepo)
+	case *events.Picture:
+		handlePicture(ctx, evt)
+	case *events.Blocklist:
+		handleBlocklist(ctx, evt)
+	case *events.CallOffer:
+		handleCallOffer(ctx, evt, chatStorageRepo)
+	case *events.CallOfferNotice:
+		handleCallOfferNotice(ctx, evt, chatStorageRepo)
+	case *events.CallAccept:
+		handleCallAccept(ctx, evt, chatStorageRepo)
+	case *events.CallReject:
+		handleCallReject(ctx, evt, chatStorageRepo)
+	case *events.CallTerminate:
+		handleCallTerminate(ctx, evt, chatStorageRepo)
 	}
 }
 
@@ -472,6 +500,30 @@ func handleMessage(ctx context.Context, evt *events.Message, chatStorageRepo dom
 		log.Errorf("Failed to store incoming message %s: %v", evt.Info.ID, err)
 	}
 
+	// Messages from blocked contacts are only stored, nothing else reacts to them, including read receipts and media downloads
+	if config.WhatsappDropBlockedMessages && !evt.Info.IsFromMe && IsBlockedSender(evt.Info.MessageSource) {
+		log.Infof("Dropping message %s from blocked sender %s", evt.Info.ID, evt.Info.Sender)
+		return
+	}
+
+	// Archive posts of followed newsletters
+	handleNewsletterMessage(evt, chatStorageRepo)
+
+	// Record poll creations and decrypt poll votes
+	handlePollMessage(ctx, evt, chatStorageRepo)
+
+	// Track disappearing timer changes made from any device
+	handleEphemeralSetting(evt, chatStorageRepo)
+
+	// Apply message and caption edits to the stored message
+	handleMessageEdit(evt, chatStorageRepo)
+
+	// Track messages pinned inside chats
+	handlePinMessage(ctx, evt, chatStorageRepo)
+
+	// Track reactions to stored messages
+	handleReactionMessage(ctx, evt, chatStorageRepo)
+
 	// Handle image message if present
 	handleImageMessage(ctx, evt)
 
@@ -512,6 +564,11 @@ func handleImageMessage(ctx context.Context, evt *events.Message) {
 	}
 }
 
+// markRead sends read receipts for messages, tests replace it to observe receipts without a connection
+var markRead = func(ids []types.MessageID, timestamp time.Time, chat, sender types.JID) error {
+	return cli.MarkRead(ids, timestamp, chat, sender)
+}
+
 func handleAutoMarkRead(_ context.Context, evt *events.Message) {
 	// Only mark read if auto-mark read is enabled and message is incoming
 	if !config.WhatsappAutoMarkRead || evt.Info.IsFromMe {
@@ -524,7 +581,7 @@ func handleAutoMarkRead(_ context.Context, evt *events.Message) {
 	chat := evt.Info.Chat
 	sender := evt.Info.Sender
 
-	if err := cli.MarkRead(messageIDs, timestamp, chat, sender); err != nil {
+	if err := markRead(messageIDs, timestamp, chat, sender); err != nil {
 		log.Warnf("Failed to mark message %s as read: %v", evt.Info.ID, err)
 	} else {
 		log.Debugf("Marked message %s as read", evt.Info.ID)
@@ -627,6 +684,7 @@ func handleAutoReply(ctx context.Context, evt *events.Message, chatStorageRepo d
 			senderJID,                       // Our JID as sender
 			recipientJID.String(),           // Recipient JID
 			config.WhatsappAutoReplyMessage, // Auto-reply content
+			"",                              // Auto-replies are plain text
 			response.Timestamp,              // Timestamp from response
 		); err != nil {
 			// Log storage error but don't fail the auto-reply
@@ -638,6 +696,11 @@ func handleAutoReply(ctx context.Context, evt *events.Message, chatStorageRepo d
 }
 
 func handleWebhookForward(ctx context.Context, evt *events.Message) {
+	// Pins are forwarded as message.pin events by handlePinMessage
+	if evt.Message.GetPinInChatMessage() != nil {
+		return
+	}
+
 	// Skip webhook for specific protocol messages that shouldn't trigger webhooks
 	if protocolMessage := evt.Message.GetProtocolMessage(); protocolMessage != nil {
 		protocolType := protocolMessage.GetType().String()
@@ -658,7 +721,7 @@ func handleWebhookForward(ctx context.Context, evt *events.Message) {
 	}
 }
 
-func handleReceipt(ctx context.Context, evt *events.Receipt) {
+func handleReceipt(ctx context.Context, evt *events.Receipt, chatStorageRepo domainChatStorage.IChatStorageRepository) {
 	sendReceipt := false
 	switch evt.Type {
 	case types.ReceiptTypeRead, types.ReceiptTypeReadSelf:
@@ -669,6 +732,8 @@ func handleReceipt(ctx context.Context, evt *events.Receipt) {
 		log.Infof("%s was delivered to %s at %s: %+v", evt.MessageIDs[0], evt.SourceString(), evt.Timestamp, evt)
 	}
 
+	storeReceipt(ctx, evt, chatStorageRepo)
+
 	// Forward receipt (ack) event to webhook if configured
 	// Note: Receipt events are not rate limited as they are critical for message delivery status
 	if len(config.WhatsappWebhook) > 0 && sendReceipt {
@@ -680,18 +745,6 @@ func handleReceipt(ctx context.Context, evt *events.Receipt) {
 	}
 }
 
-func handlePresence(_ context.Context, evt *events.Presence) {
-	if evt.Unavailable {
-		if evt.LastSeen.IsZero() {
-			log.Infof("%s is now offline", evt.From)
-		} else {
-			log.Infof("%s is now offline (last seen: %s)", evt.From, evt.LastSeen)
-		}
-	} else {
-		log.Infof("%s is now online", evt.From)
-	}
-}
-
 func handleHistorySync(ctx context.Context, evt *events.HistorySync, chatStorageRepo domainChatStorage.IChatStorageRepository) {
 	id := atomic.AddInt32(&historySyncID, 1)
 	fileName := fmt.Sprintf("%s/history-%d-%s-%d-%s.json",
@@ -936,10 +989,16 @@ func processPushNames(_ context.Context, data *waHistorySync.HistorySync, chatSt
 	return nil
 }
 
-func handleGroupInfo(ctx context.Context, evt *events.GroupInfo) {
+func handleGroupInfo(ctx context.Context, evt *events.GroupInfo, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	handleGroupEphemeral(evt, chatStorageRepo)
+	handleGroupRename(evt, chatStorageRepo)
+	handleGroupMembership(evt, chatStorageRepo)
+
 	// Only process events that have actual changes
 	hasChanges := len(evt.Join) > 0 || len(evt.Leave) > 0 || len(evt.Promote) > 0 || len(evt.Demote) > 0 ||
-		evt.Name != nil || evt.Topic != nil || evt.Locked != nil || evt.Announce != nil
+		evt.Name != nil || evt.Topic != nil || evt.Locked != nil || evt.Announce != nil ||
+		evt.Ephemeral != nil || evt.MembershipApprovalMode != nil || evt.NewInviteLink != nil ||
+		evt.Delete != nil || evt.Link != nil || evt.Unlink != nil
 
 	if !hasChanges {
 		return
@@ -958,6 +1017,9 @@ func handleGroupInfo(ctx context.Context, evt *events.GroupInfo) {
 	if len(evt.Demote) > 0 {
 		log.Infof("Group %s: %d users demoted at %s", evt.JID, len(evt.Demote), evt.Timestamp)
 	}
+	if evt.Sender != nil {
+		log.Infof("Group %s: changes made by %s", evt.JID, evt.Sender)
+	}
 
 	// Forward group info event to webhook if configured
 	if len(config.WhatsappWebhook) > 0 {
@@ -968,3 +1030,34 @@ func handleGroupInfo(ctx context.Context, evt *events.GroupInfo) {
 		}(evt)
 	}
 }
+
+// handleGroupRename keeps the stored chat name in sync when a group is renamed
+func handleGroupRename(evt *events.GroupInfo, chatStorageRepo domainChatStorage.IChatStorageRepository) {
+	if chatStorageRepo == nil || evt.Name == nil || evt.Name.Name == "" {
+		return
+	}
+
+	if err := chatStorageRepo.UpdateChatName(evt.JID.String(), evt.Name.Name); err != nil {
+		log.Errorf("Failed to update name of group %s: %v", evt.JID, err)
+		return
+	}
+
+	log.Infof("Group %s renamed to %q", evt.JID, evt.Name.Name)
+}
+
+// handlePicture forwards group picture changes; profile picture changes of contacts are ignored
+func handlePicture(ctx context.Context, evt *events.Picture) {
+	if evt.JID.Server != types.GroupServer {
+		return
+	}
+
+	log.Infof("Group %s: picture changed by %s (removed: %t)", evt.JID, evt.Author, evt.Remove)
+
+	if len(config.WhatsappWebhook) > 0 {
+		go func(e *events.Picture) {
+			if err := forwardGroupPictureToWebhook(ctx, e); err != nil {
+				logrus.Errorf("Failed to forward group picture event to webhook: %v", err)
+			}
+		}(evt)
+	}
+}
@@ -0,0 +1,108 @@
+package whatsapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/stretchr/testify/assert"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// messageStore records stored messages, any other repository call fails the test with a nil pointer panic
+type messageStore struct {
+	domainChatStorage.IChatStorageRepository
+	stored []string
+}
+
+func (s *messageStore) StoreMessage(message *domainChatStorage.Message) error {
+	s.stored = append(s.stored, message.ID)
+	return nil
+}
+
+func TestHandleMessageBlockedSender(t *testing.T) {
+	blocked := types.NewJID("6289600000001", types.DefaultUserServer)
+	allowed := types.NewJID("6289600000002", types.DefaultUserServer)
+
+	tests := []struct {
+		name          string
+		sender        types.JID
+		dropBlocked   bool
+		wantMarkRead  bool
+		wantStoredIDs []string
+	}{
+		{
+			name:          "should not mark read a message from a blocked sender",
+			sender:        blocked,
+			dropBlocked:   true,
+			wantMarkRead:  false,
+			wantStoredIDs: []string{"MSG1"},
+		},
+		{
+			name:          "should mark read a message from a blocked sender when dropping is off",
+			sender:        blocked,
+			dropBlocked:   false,
+			wantMarkRead:  true,
+			wantStoredIDs: []string{"MSG1"},
+		},
+		{
+			name:          "should mark read a message from a sender that is not blocked",
+			sender:        allowed,
+			dropBlocked:   true,
+			wantMarkRead:  true,
+			wantStoredIDs: []string{"MSG1"},
+		},
+	}
+
+	log = waLog.Noop
+	originalMarkRead, originalAutoMarkRead, originalDropBlocked := markRead, config.WhatsappAutoMarkRead, config.WhatsappDropBlockedMessages
+	originalWebhook, originalAutoReply := config.WhatsappWebhook, config.WhatsappAutoReplyMessage
+	t.Cleanup(func() {
+		markRead = originalMarkRead
+		config.WhatsappAutoMarkRead = originalAutoMarkRead
+		config.WhatsappDropBlockedMessages = originalDropBlocked
+		config.WhatsappWebhook = originalWebhook
+		config.WhatsappAutoReplyMessage = originalAutoReply
+		SetBlocklist(&types.Blocklist{})
+		SetChatStorageRepository(nil)
+	})
+
+	config.WhatsappAutoMarkRead = true
+	config.WhatsappWebhook = nil
+	config.WhatsappAutoReplyMessage = ""
+	SetBlocklist(&types.Blocklist{JIDs: []types.JID{blocked}})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.WhatsappDropBlockedMessages = tt.dropBlocked
+
+			markedRead := false
+			markRead = func(ids []types.MessageID, timestamp time.Time, chat, sender types.JID) error {
+				markedRead = true
+				return nil
+			}
+
+			store := &messageStore{}
+			SetChatStorageRepository(store)
+
+			evt := &events.Message{
+				Info: types.MessageInfo{
+					MessageSource: types.MessageSource{Chat: tt.sender, Sender: tt.sender},
+					ID:            "MSG1",
+					Timestamp:     time.Now(),
+				},
+				Message: &waE2E.Message{Conversation: proto.String("hello")},
+			}
+			handleMessage(context.Background(), evt, store)
+
+			assert.Equal(t, tt.wantMarkRead, markedRead)
+			assert.Equal(t, tt.wantStoredIDs, store.stored)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"encoding/csv"
 	"fmt"
 	"image"
 	_ "image/gif"  // Register GIF format
@@ -9,6 +10,7 @@ import (
 	_ "image/png"  // For PNG encoding
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -21,6 +23,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
 	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow/types"
 	_ "golang.org/x/image/webp" // Register WebP format
 )
 
@@ -85,6 +88,11 @@ func GetMetaDataFromURL(urlStr string) (meta Metadata, err error) {
 	// Create HTTP client with timeout
 	client := &http.Client{
 		Timeout: 15 * time.Second,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         (&net.Dialer{Timeout: 5 * time.Second}).DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if len(via) >= 10 {
 				return fmt.Errorf("too many redirects")
@@ -110,8 +118,12 @@ func GetMetaDataFromURL(urlStr string) (meta Metadata, err error) {
 		return meta, fmt.Errorf("HTTP request failed with status: %s", response.Status)
 	}
 
-	// Parse the HTML document
-	document, err := goquery.NewDocumentFromReader(response.Body)
+	if response.ContentLength > config.WhatsappSettingMaxPreviewSize {
+		return meta, fmt.Errorf("page size %d exceeds the maximum allowed size of %d bytes", response.ContentLength, config.WhatsappSettingMaxPreviewSize)
+	}
+
+	// Parse the HTML document, reading no more than the preview size limit
+	document, err := goquery.NewDocumentFromReader(io.LimitReader(response.Body, config.WhatsappSettingMaxPreviewSize))
 	if err != nil {
 		return meta, err
 	}
@@ -176,10 +188,12 @@ func GetMetaDataFromURL(urlStr string) (meta Metadata, err error) {
 				if !strings.HasPrefix(contentType, "image/") {
 					logrus.Warnf("URL returned non-image content type: %s", contentType)
 				} else {
-					// Read image data with size limit
-					imageData, err := io.ReadAll(io.LimitReader(imgResponse.Body, int64(config.WhatsappSettingMaxImageSize)))
+					// Read image data with size limit, one extra byte tells us the image was truncated
+					imageData, err := io.ReadAll(io.LimitReader(imgResponse.Body, config.WhatsappSettingMaxPreviewSize+1))
 					if err != nil {
 						logrus.Warnf("Failed to read image data: %v", err)
+					} else if int64(len(imageData)) > config.WhatsappSettingMaxPreviewSize {
+						logrus.Warnf("Preview image exceeds the maximum allowed size of %d bytes", config.WhatsappSettingMaxPreviewSize)
 					} else if len(imageData) == 0 {
 						logrus.Warn("Downloaded image data is empty")
 					} else {
@@ -216,6 +230,15 @@ func GetMetaDataFromURL(urlStr string) (meta Metadata, err error) {
 	return meta, nil
 }
 
+// ExtractFirstURL returns the first http(s) URL found in the text, or an empty string when there is none
+func ExtractFirstURL(text string) string {
+	match := urlPattern.FindString(text)
+	// Trailing punctuation usually belongs to the sentence, not to the URL
+	return strings.TrimRight(match, ".,;:!?)]}'\"")
+}
+
+var urlPattern = regexp.MustCompile(`(?i)https?://[^\s<>"]+`)
+
 // ContainsMention is checking if message contains mention, then return only mention without @
 func ContainsMention(message string) []string {
 	// Regular expression to find all phone numbers after the @ symbol
@@ -439,7 +462,57 @@ func DownloadVideoFromURL(videoURL string) ([]byte, string, error) {
 	return videoData, fileName, nil
 }
 
-// FormatBusinessHourTime converts numeric time format (e.g., 600, 1200) to HH:MM format (e.g., "06:00", "12:00")
+// DownloadFileFromURL downloads a document from the provided URL and returns the bytes and sanitized filename.
+// Any content type is accepted, the size is limited to WhatsappSettingMaxFileSize like uploaded files.
+func DownloadFileFromURL(fileURL string) ([]byte, string, error) {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("too many redirects")
+			}
+			return nil
+		},
+	}
+
+	resp, err := client.Get(fileURL)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("HTTP request failed with status: %s", resp.Status)
+	}
+
+	maxSize := config.WhatsappSettingMaxFileSize
+	if resp.ContentLength > 0 && resp.ContentLength > maxSize {
+		return nil, "", fmt.Errorf("file size %d exceeds maximum allowed size %d", resp.ContentLength, maxSize)
+	}
+
+	// Guard against unknown Content-Length by limiting reader
+	limitedReader := &io.LimitedReader{R: resp.Body, N: maxSize + 1}
+	fileData, err := io.ReadAll(limitedReader)
+	if err != nil {
+		return nil, "", err
+	}
+	if int64(len(fileData)) > maxSize {
+		return nil, "", fmt.Errorf("downloaded file size of %d bytes exceeds the maximum allowed size of %d bytes", len(fileData), maxSize)
+	}
+
+	// Derive filename from URL path
+	segments := strings.Split(fileURL, "/")
+	fileName := segments[len(segments)-1]
+	fileName = strings.Split(fileName, "?")[0]
+	if fileName == "" {
+		fileName = fmt.Sprintf("file_%d", time.Now().Unix())
+	}
+
+	return fileData, fileName, nil
+}
+
+// FormatBusinessHourTime converts minutes since midnight as WhatsApp reports business hours (e.g., 360, 720) to HH:MM
+// format (e.g., "06:00", "12:00")
 func FormatBusinessHourTime(timeValue any) string {
 	var timeInt int
 
@@ -467,8 +540,80 @@ func FormatBusinessHourTime(timeValue any) string {
 	}
 
 	// Extract hours and minutes
-	hours := timeInt / 100
-	minutes := timeInt % 100
+	hours := timeInt / 60
+	minutes := timeInt % 60
 
 	return fmt.Sprintf("%02d:%02d", hours, minutes)
 }
+
+// IsWithinBusinessHours reports whether now falls in the business hours of a WhatsApp business profile.
+// Days without a configuration and appointment-only days count as closed. Without any business hours
+// the business is treated as always open, and an unknown timezone falls back to UTC.
+func IsWithinBusinessHours(now time.Time, timezone string, hours []types.BusinessHoursConfig) bool {
+	if len(hours) == 0 {
+		return true
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	now = now.In(location)
+	day := strings.ToLower(now.Weekday().String()[:3])
+	minute := now.Hour()*60 + now.Minute()
+
+	for _, entry := range hours {
+		if entry.DayOfWeek != day {
+			continue
+		}
+		switch entry.Mode {
+		case "open_24h":
+			return true
+		case "specific_hours":
+			openTime, openErr := strconv.Atoi(entry.OpenTime)
+			closeTime, closeErr := strconv.Atoi(entry.CloseTime)
+			if openErr == nil && closeErr == nil && minute >= openTime && minute < closeTime {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParsePhoneNumbersCSV reads phone numbers from the first column of a CSV. Spaces, dashes, dots, parentheses
+// and a leading plus are stripped; a header row, blank cells and duplicates are skipped. Values are not
+// otherwise validated so callers can report invalid rows individually.
+func ParsePhoneNumbersCSV(reader io.Reader) ([]string, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	cleaner := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "+", "", "\ufeff", "")
+	seen := make(map[string]bool)
+	numbers := []string{}
+
+	for row := 1; ; row++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		number := cleaner.Replace(strings.TrimSpace(record[0]))
+		if number == "" || seen[number] {
+			continue
+		}
+
+		// A first row without any digit is a header such as "phone"
+		if row == 1 && !strings.ContainsAny(number, "0123456789") {
+			continue
+		}
+
+		seen[number] = true
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
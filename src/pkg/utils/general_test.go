@@ -14,6 +14,7 @@ import (
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"go.mau.fi/whatsmeow/types"
 )
 
 type UtilsTestSuite struct {
@@ -53,6 +54,63 @@ func (suite *UtilsTestSuite) TestContainsMention() {
 	}
 }
 
+func (suite *UtilsTestSuite) TestExtractFirstURL() {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "should return empty when there is no url",
+			message: "hello world",
+			want:    "",
+		},
+		{
+			name:    "should return the only url",
+			message: "check https://example.com/path?q=1 now",
+			want:    "https://example.com/path?q=1",
+		},
+		{
+			name:    "should return the first of many urls",
+			message: "http://first.example.com and https://second.example.com",
+			want:    "http://first.example.com",
+		},
+		{
+			name:    "should strip trailing punctuation",
+			message: "have you seen https://example.com/page?",
+			want:    "https://example.com/page",
+		},
+		{
+			name:    "should ignore urls without scheme",
+			message: "visit example.com today",
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.ExtractFirstURL(tt.message))
+		})
+	}
+}
+
+func (suite *UtilsTestSuite) TestParseNewsletterInviteCode() {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"invite link", "https://whatsapp.com/channel/0029VaZ4Ba3JENy2GQ4Xeq1t", "0029VaZ4Ba3JENy2GQ4Xeq1t"},
+		{"invite link with www and query", "https://www.whatsapp.com/channel/0029VaZ4Ba3JENy2GQ4Xeq1t?utm=share", "0029VaZ4Ba3JENy2GQ4Xeq1t"},
+		{"invite link with trailing slash", "https://whatsapp.com/channel/0029VaZ4Ba3JENy2GQ4Xeq1t/", "0029VaZ4Ba3JENy2GQ4Xeq1t"},
+		{"bare invite code", " 0029VaZ4Ba3JENy2GQ4Xeq1t ", "0029VaZ4Ba3JENy2GQ4Xeq1t"},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.ParseNewsletterInviteCode(tt.link))
+		})
+	}
+}
+
 func (suite *UtilsTestSuite) TestRemoveFile() {
 	tempFile, err := os.CreateTemp("", "testfile")
 	assert.NoError(suite.T(), err)
@@ -192,6 +250,20 @@ func (suite *UtilsTestSuite) TestGetMetaDataFromURLEdgeCases() {
 	assert.Equal(suite.T(), "Image Test", meta.Title)
 	assert.Contains(suite.T(), meta.Image, "/invalid.jpg")
 	// Image download may fail but meta should still be extracted
+
+	// Test page larger than the preview size limit
+	originalMaxPreviewSize := config.WhatsappSettingMaxPreviewSize
+	config.WhatsappSettingMaxPreviewSize = 64
+	defer func() { config.WhatsappSettingMaxPreviewSize = originalMaxPreviewSize }()
+
+	largeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<!DOCTYPE html><html><head><title>` + strings.Repeat("a", 128) + `</title></head></html>`))
+	}))
+	defer largeServer.Close()
+
+	_, err = utils.GetMetaDataFromURL(largeServer.URL)
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "exceeds the maximum allowed size")
 }
 
 func (suite *UtilsTestSuite) TestDownloadImageFromURL() {
@@ -604,6 +676,159 @@ func (suite *UtilsTestSuite) TestDownloadVideoFromURL() {
 	assert.Contains(suite.T(), err.Error(), "too many redirects")
 }
 
+func (suite *UtilsTestSuite) TestParsePhoneNumbersCSV() {
+	tests := []struct {
+		name     string
+		csv      string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "first column with header",
+			csv:      "phone,name\n6281234567890,Alice\n6289876543210,Bob\n",
+			expected: []string{"6281234567890", "6289876543210"},
+		},
+		{
+			name:     "formatted numbers without header",
+			csv:      "+62 812-3456-7890\n(62) 898.7654.3210\n",
+			expected: []string{"6281234567890", "6289876543210"},
+		},
+		{
+			name:     "skips blank rows and duplicates",
+			csv:      "6281234567890\n\n,Nobody\n+6281234567890\n",
+			expected: []string{"6281234567890"},
+		},
+		{
+			name:     "keeps invalid values after the header",
+			csv:      "\ufeffPhone\nnot-a-number\n",
+			expected: []string{"notanumber"},
+		},
+		{
+			name:     "empty file",
+			csv:      "",
+			expected: []string{},
+		},
+		{
+			name:    "malformed quoting",
+			csv:     "\"6281234567890\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			numbers, err := utils.ParsePhoneNumbersCSV(strings.NewReader(tt.csv))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, numbers)
+		})
+	}
+}
+
+func (suite *UtilsTestSuite) TestFormatBusinessHourTime() {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{value: 0, want: "00:00"},
+		{value: "570", want: "09:30"},
+		{value: 1080, want: "18:00"},
+		{value: uint32(1440), want: "24:00"},
+		{value: "closed", want: "closed"},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.FormatBusinessHourTime(tt.value))
+		})
+	}
+}
+
+func (suite *UtilsTestSuite) TestIsWithinBusinessHours() {
+	hours := []types.BusinessHoursConfig{
+		{DayOfWeek: "mon", Mode: "specific_hours", OpenTime: "540", CloseTime: "1020"},
+		{DayOfWeek: "tue", Mode: "specific_hours", OpenTime: "540", CloseTime: "720"},
+		{DayOfWeek: "tue", Mode: "specific_hours", OpenTime: "780", CloseTime: "1020"},
+		{DayOfWeek: "sat", Mode: "open_24h"},
+		{DayOfWeek: "sun", Mode: "appointment_only"},
+	}
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		timezone string
+		hours    []types.BusinessHoursConfig
+		want     bool
+	}{
+		{"monday morning", time.Date(2024, 1, 1, 9, 0, 0, 0, jakarta), "Asia/Jakarta", hours, true},
+		{"monday at closing time", time.Date(2024, 1, 1, 17, 0, 0, 0, jakarta), "Asia/Jakarta", hours, false},
+		{"tuesday lunch break", time.Date(2024, 1, 2, 12, 30, 0, 0, jakarta), "Asia/Jakarta", hours, false},
+		{"tuesday afternoon", time.Date(2024, 1, 2, 13, 0, 0, 0, jakarta), "Asia/Jakarta", hours, true},
+		{"converted to the business timezone", time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC), "Asia/Jakarta", hours, true},
+		{"day without hours", time.Date(2024, 1, 3, 10, 0, 0, 0, jakarta), "Asia/Jakarta", hours, false},
+		{"open 24 hours", time.Date(2024, 1, 6, 3, 0, 0, 0, jakarta), "Asia/Jakarta", hours, true},
+		{"appointment only", time.Date(2024, 1, 7, 10, 0, 0, 0, jakarta), "Asia/Jakarta", hours, false},
+		{"unknown timezone uses UTC", time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), "Mars/Olympus", hours, true},
+		{"no business hours", time.Date(2024, 1, 3, 3, 0, 0, 0, jakarta), "", nil, true},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.IsWithinBusinessHours(tt.now, tt.timezone, tt.hours))
+		})
+	}
+}
+
+func (suite *UtilsTestSuite) TestTypingDuration() {
+	tests := []struct {
+		name     string
+		text     string
+		min, max time.Duration
+	}{
+		{"empty text", "", time.Second, 1250 * time.Millisecond},
+		{"short text", "ok", time.Second, 1250 * time.Millisecond},
+		{"80 characters", strings.Repeat("a", 80), 7500 * time.Millisecond, 12500 * time.Millisecond},
+		{"counts runes", strings.Repeat("é", 80), 7500 * time.Millisecond, 12500 * time.Millisecond},
+		{"long text", strings.Repeat("a", 1000), 11250 * time.Millisecond, 15 * time.Second},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				duration := utils.TypingDuration(tt.text)
+				assert.GreaterOrEqual(t, duration, tt.min)
+				assert.LessOrEqual(t, duration, tt.max)
+			}
+		})
+	}
+}
+
+func (suite *UtilsTestSuite) TestRecordingDuration() {
+	tests := []struct {
+		name     string
+		size     uint64
+		min, max time.Duration
+	}{
+		{"empty file", 0, 2 * time.Second, 2500 * time.Millisecond},
+		{"ten seconds", 40000, 7500 * time.Millisecond, 12500 * time.Millisecond},
+		{"long recording", 1 << 20, 22500 * time.Millisecond, 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				duration := utils.RecordingDuration(tt.size)
+				assert.GreaterOrEqual(t, duration, tt.min)
+				assert.LessOrEqual(t, duration, tt.max)
+			}
+		})
+	}
+}
+
 func TestUtilsTestSuite(t *testing.T) {
 	suite.Run(t, new(UtilsTestSuite))
 }
@@ -0,0 +1,593 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MessageFormatMarkdown marks text that must be converted from CommonMark before sending
+	MessageFormatMarkdown = "markdown"
+
+	whatsappBold      = "*"
+	whatsappItalic    = "_"
+	whatsappStrike    = "~"
+	whatsappCodeBlock = "```"
+)
+
+var (
+	markdownATXHeading  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	markdownSetextLine  = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	markdownFence       = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	markdownBullet      = regexp.MustCompile(`^([ \t]*)([-*+])(?:[ \t]+(.*))?$`)
+	markdownOrdered     = regexp.MustCompile(`^([ \t]*)([0-9]{1,9})[.)](?:[ \t]+(.*))?$`)
+	markdownTaskItem    = regexp.MustCompile(`^\[([ xX])\][ \t]+`)
+	markdownBlockQuote  = regexp.MustCompile(`^ {0,3}>[ ]?`)
+	markdownIndentedRow = regexp.MustCompile(`^(?: {4}|\t)`)
+)
+
+// FormatMessageText converts text written in the given format to WhatsApp markup; unknown formats are returned unchanged
+func FormatMessageText(format string, text string) string {
+	if format == MessageFormatMarkdown {
+		return MarkdownToWhatsApp(text)
+	}
+	return text
+}
+
+// MarkdownToWhatsApp converts CommonMark (with GitHub strikethrough and task lists) to WhatsApp markup:
+// strong becomes *bold*, emphasis _italic_, ~~strike~~ ~strike~, code blocks ``` and links "text (url)".
+func MarkdownToWhatsApp(markdown string) string {
+	markdown = strings.ReplaceAll(markdown, "\r\n", "\n")
+	lines := strings.Split(markdown, "\n")
+
+	var out []string
+	var paragraph []string
+	lastWasList := false
+
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			out = append(out, strings.Split(convertMarkdownInline(strings.Join(paragraph, "\n"), false), "\n")...)
+			paragraph = nil
+		}
+	}
+	appendBlank := func() {
+		if len(out) > 0 && out[len(out)-1] != "" {
+			out = append(out, "")
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if strings.TrimSpace(line) == "" {
+			flushParagraph()
+			appendBlank()
+			continue
+		}
+
+		// Fenced code block, kept verbatim until the closing fence or the end of the text
+		if m := markdownFence.FindStringSubmatch(line); m != nil {
+			flushParagraph()
+			indent, fence := len(m[1]), m[2]
+			var code []string
+			for i++; i < len(lines); i++ {
+				trimmed := strings.TrimSpace(lines[i])
+				if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+					break
+				}
+				code = append(code, trimLeadingSpaces(lines[i], indent))
+			}
+			out = append(out, whatsappCodeBlock+strings.Join(code, "\n")+whatsappCodeBlock)
+			lastWasList = false
+			continue
+		}
+
+		// Indented code block, which can not interrupt a paragraph or continue a list item
+		if len(paragraph) == 0 && !lastWasList && markdownIndentedRow.MatchString(line) {
+			var code []string
+			last := i
+			for j := i; j < len(lines); j++ {
+				if strings.TrimSpace(lines[j]) != "" {
+					if !markdownIndentedRow.MatchString(lines[j]) {
+						break
+					}
+					last = j
+				}
+			}
+			// Trailing blank lines belong to the surrounding text, not to the code
+			for ; i <= last; i++ {
+				code = append(code, strings.TrimPrefix(trimLeadingSpaces(lines[i], 4), "\t"))
+			}
+			i--
+			out = append(out, whatsappCodeBlock+strings.Join(code, "\n")+whatsappCodeBlock)
+			continue
+		}
+
+		// Setext heading underline turns the open paragraph into a heading
+		if m := markdownSetextLine.FindStringSubmatch(line); m != nil && len(paragraph) > 0 {
+			heading := strings.TrimSpace(strings.Join(paragraph, " "))
+			paragraph = nil
+			out = append(out, wrapMarkdownHeading(heading))
+			lastWasList = false
+			continue
+		}
+
+		if isMarkdownThematicBreak(line) {
+			flushParagraph()
+			out = append(out, "———")
+			lastWasList = false
+			continue
+		}
+
+		if m := markdownATXHeading.FindStringSubmatch(line); m != nil {
+			flushParagraph()
+			out = append(out, wrapMarkdownHeading(strings.TrimSpace(m[2])))
+			lastWasList = false
+			continue
+		}
+
+		// Block quote, WhatsApp only renders a single quote level so nested quotes are flattened
+		if markdownBlockQuote.MatchString(line) {
+			flushParagraph()
+			var quoted []string
+			for ; i < len(lines) && markdownBlockQuote.MatchString(lines[i]); i++ {
+				quoted = append(quoted, markdownBlockQuote.ReplaceAllString(lines[i], ""))
+			}
+			i--
+			for _, quotedLine := range strings.Split(MarkdownToWhatsApp(strings.Join(quoted, "\n")), "\n") {
+				for strings.HasPrefix(quotedLine, ">") {
+					quotedLine = strings.TrimPrefix(strings.TrimPrefix(quotedLine, ">"), " ")
+				}
+				out = append(out, strings.TrimRight("> "+quotedLine, " "))
+			}
+			lastWasList = false
+			continue
+		}
+
+		if m := markdownBullet.FindStringSubmatch(line); m != nil {
+			flushParagraph()
+			out = append(out, markdownListIndent(m[1])+"- "+convertMarkdownListItem(m[3]))
+			lastWasList = true
+			continue
+		}
+
+		// An ordered list can only interrupt a paragraph when it starts at 1
+		if m := markdownOrdered.FindStringSubmatch(line); m != nil && (len(paragraph) == 0 || m[2] == "1") {
+			flushParagraph()
+			number, _ := strconv.Atoi(m[2])
+			out = append(out, markdownListIndent(m[1])+strconv.Itoa(number)+". "+convertMarkdownListItem(m[3]))
+			lastWasList = true
+			continue
+		}
+
+		// Continuation line of a list item
+		if lastWasList && len(paragraph) == 0 && (line[0] == ' ' || line[0] == '\t') {
+			out = append(out, markdownListIndent(line[:len(line)-len(strings.TrimLeft(line, " \t"))])+convertMarkdownInline(strings.TrimSpace(line), false))
+			continue
+		}
+
+		paragraph = append(paragraph, strings.TrimSpace(line))
+		lastWasList = false
+	}
+	flushParagraph()
+
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return strings.Join(out, "\n")
+}
+
+// wrapMarkdownHeading renders a heading as bold text; strong emphasis inside it is dropped to avoid nested bold markers
+func wrapMarkdownHeading(heading string) string {
+	heading = convertMarkdownInline(heading, true)
+	if heading == "" {
+		return ""
+	}
+	return whatsappBold + heading + whatsappBold
+}
+
+// convertMarkdownListItem converts the content of a list item, rendering GitHub task list checkboxes
+func convertMarkdownListItem(content string) string {
+	if m := markdownTaskItem.FindStringSubmatch(content); m != nil {
+		box := "☐ "
+		if m[1] != " " {
+			box = "☑ "
+		}
+		return box + convertMarkdownInline(content[len(m[0]):], false)
+	}
+	return convertMarkdownInline(content, false)
+}
+
+// markdownListIndent keeps nesting visible with two spaces per level
+func markdownListIndent(indent string) string {
+	width := 0
+	for _, r := range indent {
+		if r == '\t' {
+			width += 4
+		} else {
+			width++
+		}
+	}
+	return strings.Repeat("  ", width/2)
+}
+
+func isMarkdownThematicBreak(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if len(line)-len(strings.TrimLeft(line, " ")) > 3 || len(trimmed) < 3 {
+		return false
+	}
+	marker := trimmed[0]
+	if marker != '-' && marker != '*' && marker != '_' {
+		return false
+	}
+	count := 0
+	for i := 0; i < len(trimmed); i++ {
+		switch trimmed[i] {
+		case marker:
+			count++
+		case ' ', '\t':
+		default:
+			return false
+		}
+	}
+	return count >= 3
+}
+
+func trimLeadingSpaces(line string, max int) string {
+	for i := 0; i < max && strings.HasPrefix(line, " "); i++ {
+		line = line[1:]
+	}
+	return line
+}
+
+// markdownInlineNode is either literal text or a delimiter run of *, _ or ~ that may become emphasis
+type markdownInlineNode struct {
+	text string
+
+	delim     byte
+	length    int // original run length
+	remaining int // characters not yet matched
+	canOpen   bool
+	canClose  bool
+	active    bool
+	openTags  []string // matched as opener, innermost first
+	closeTags []string // matched as closer, innermost first
+}
+
+func (n *markdownInlineNode) render() string {
+	if n.delim == 0 {
+		return n.text
+	}
+	var sb strings.Builder
+	for _, tag := range n.closeTags {
+		sb.WriteString(tag)
+	}
+	sb.WriteString(strings.Repeat(string(n.delim), n.remaining))
+	for i := len(n.openTags) - 1; i >= 0; i-- {
+		sb.WriteString(n.openTags[i])
+	}
+	return sb.String()
+}
+
+// convertMarkdownInline converts inline CommonMark: code spans, links, autolinks, escapes and emphasis
+func convertMarkdownInline(text string, inHeading bool) string {
+	nodes := parseMarkdownInline(text, inHeading)
+	processMarkdownEmphasis(nodes, inHeading)
+
+	var sb strings.Builder
+	for _, node := range nodes {
+		sb.WriteString(node.render())
+	}
+	return sb.String()
+}
+
+func parseMarkdownInline(text string, inHeading bool) []*markdownInlineNode {
+	var nodes []*markdownInlineNode
+	var literal strings.Builder
+
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			nodes = append(nodes, &markdownInlineNode{text: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && text[i+1] == '\n':
+			// Backslash hard line break, WhatsApp keeps every line break anyway
+			i++
+
+		case c == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]):
+			literal.WriteByte(text[i+1])
+			i += 2
+
+		case c == '`':
+			run := countRun(text, i, '`')
+			if end := findBacktickClose(text, i+run, run); end >= 0 {
+				flushLiteral()
+				nodes = append(nodes, &markdownInlineNode{text: renderMarkdownCode(text[i+run : end])})
+				i = end + run
+			} else {
+				literal.WriteString(text[i : i+run])
+				i += run
+			}
+
+		case c == '<':
+			if end := strings.IndexByte(text[i:], '>'); end > 0 && isMarkdownAutolink(text[i+1:i+end]) {
+				literal.WriteString(text[i+1 : i+end])
+				i += end + 1
+			} else {
+				literal.WriteByte(c)
+				i++
+			}
+
+		case c == '[' || (c == '!' && i+1 < len(text) && text[i+1] == '['):
+			image := c == '!'
+			start := i
+			if image {
+				start++
+			}
+			if label, dest, end, ok := parseMarkdownLink(text, start); ok {
+				flushLiteral()
+				nodes = append(nodes, &markdownInlineNode{text: renderMarkdownLink(label, dest, image, inHeading)})
+				i = end
+			} else {
+				literal.WriteByte(c)
+				i++
+			}
+
+		case c == '*' || c == '_' || c == '~':
+			run := countRun(text, i, c)
+			flushLiteral()
+			before, _ := utf8.DecodeLastRuneInString(text[:i])
+			if i == 0 {
+				before = '\n'
+			}
+			after, _ := utf8.DecodeRuneInString(text[i+run:])
+			if i+run >= len(text) {
+				after = '\n'
+			}
+			leftFlanking := !unicode.IsSpace(after) && (!isUnicodePunct(after) || unicode.IsSpace(before) || isUnicodePunct(before))
+			rightFlanking := !unicode.IsSpace(before) && (!isUnicodePunct(before) || unicode.IsSpace(after) || isUnicodePunct(after))
+
+			node := &markdownInlineNode{delim: c, length: run, remaining: run, active: true}
+			switch c {
+			case '_':
+				node.canOpen = leftFlanking && (!rightFlanking || isUnicodePunct(before))
+				node.canClose = rightFlanking && (!leftFlanking || isUnicodePunct(after))
+			default:
+				node.canOpen = leftFlanking
+				node.canClose = rightFlanking
+			}
+			if c == '~' && run > 2 {
+				node.active = false
+			}
+			nodes = append(nodes, node)
+			i += run
+
+		default:
+			literal.WriteByte(c)
+			i++
+		}
+	}
+	flushLiteral()
+
+	return nodes
+}
+
+// processMarkdownEmphasis matches delimiter runs following the CommonMark "process emphasis" procedure
+func processMarkdownEmphasis(nodes []*markdownInlineNode, inHeading bool) {
+	strong := whatsappBold
+	if inHeading {
+		strong = ""
+	}
+
+	for closerIdx := 0; closerIdx < len(nodes); closerIdx++ {
+		closer := nodes[closerIdx]
+		for closer.delim != 0 && closer.active && closer.canClose && closer.remaining > 0 {
+			openerIdx := -1
+			for j := closerIdx - 1; j >= 0; j-- {
+				opener := nodes[j]
+				if opener.delim != closer.delim || !opener.active || !opener.canOpen || opener.remaining == 0 {
+					continue
+				}
+				if closer.delim == '~' {
+					if opener.remaining != closer.remaining {
+						continue
+					}
+				} else if (opener.canClose || closer.canOpen) &&
+					(opener.length+closer.length)%3 == 0 &&
+					!(opener.length%3 == 0 && closer.length%3 == 0) {
+					continue
+				}
+				openerIdx = j
+				break
+			}
+			if openerIdx < 0 {
+				break
+			}
+
+			opener := nodes[openerIdx]
+			var tag string
+			var use int
+			switch {
+			case closer.delim == '~':
+				tag, use = whatsappStrike, closer.remaining
+			case opener.remaining >= 2 && closer.remaining >= 2:
+				tag, use = strong, 2
+			default:
+				tag, use = whatsappItalic, 1
+			}
+
+			opener.openTags = append(opener.openTags, tag)
+			closer.closeTags = append(closer.closeTags, tag)
+			opener.remaining -= use
+			closer.remaining -= use
+
+			// Delimiters between a matched pair can no longer match anything
+			for k := openerIdx + 1; k < closerIdx; k++ {
+				nodes[k].active = false
+			}
+		}
+	}
+}
+
+func renderMarkdownCode(code string) string {
+	code = strings.ReplaceAll(code, "\n", " ")
+	if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+		code = code[1 : len(code)-1]
+	}
+	if strings.Contains(code, "`") {
+		return whatsappCodeBlock + code + whatsappCodeBlock
+	}
+	return "`" + code + "`"
+}
+
+func renderMarkdownLink(label, dest string, image bool, inHeading bool) string {
+	text := label
+	if !image {
+		text = convertMarkdownInline(label, inHeading)
+	}
+	if dest == "" {
+		return text
+	}
+	if strings.TrimSpace(text) == "" || text == dest || strings.TrimPrefix(dest, "mailto:") == text {
+		return dest
+	}
+	return text + " (" + dest + ")"
+}
+
+// parseMarkdownLink parses [label](destination "title") starting at the opening bracket
+func parseMarkdownLink(text string, start int) (label string, dest string, end int, ok bool) {
+	depth := 0
+	closeIdx := -1
+	for i := start; i < len(text) && closeIdx < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '`':
+			run := countRun(text, i, '`')
+			if codeEnd := findBacktickClose(text, i+run, run); codeEnd >= 0 {
+				i = codeEnd + run - 1
+			} else {
+				i += run - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closeIdx = i
+			}
+		}
+	}
+	if closeIdx < 0 || closeIdx+1 >= len(text) || text[closeIdx+1] != '(' {
+		return "", "", 0, false
+	}
+
+	i := closeIdx + 2
+	for i < len(text) && (text[i] == ' ' || text[i] == '\n') {
+		i++
+	}
+
+	// Destination is either <...> or a run of non-space characters with balanced parentheses
+	if i < len(text) && text[i] == '<' {
+		destEnd := strings.IndexByte(text[i:], '>')
+		if destEnd < 0 {
+			return "", "", 0, false
+		}
+		dest = text[i+1 : i+destEnd]
+		i += destEnd + 1
+	} else {
+		parens := 0
+		destStart := i
+		for ; i < len(text); i++ {
+			ch := text[i]
+			if ch == ' ' || ch == '\n' {
+				break
+			}
+			if ch == '(' {
+				parens++
+			} else if ch == ')' {
+				if parens == 0 {
+					break
+				}
+				parens--
+			}
+		}
+		dest = text[destStart:i]
+	}
+
+	for i < len(text) && (text[i] == ' ' || text[i] == '\n') {
+		i++
+	}
+
+	// Optional title, which WhatsApp has no place for
+	if i < len(text) && (text[i] == '"' || text[i] == '\'' || text[i] == '(') {
+		closing := text[i]
+		if closing == '(' {
+			closing = ')'
+		}
+		titleEnd := strings.IndexByte(text[i+1:], closing)
+		if titleEnd < 0 {
+			return "", "", 0, false
+		}
+		i += titleEnd + 2
+		for i < len(text) && (text[i] == ' ' || text[i] == '\n') {
+			i++
+		}
+	}
+
+	if i >= len(text) || text[i] != ')' {
+		return "", "", 0, false
+	}
+
+	return text[start+1 : closeIdx], dest, i + 1, true
+}
+
+func isMarkdownAutolink(s string) bool {
+	if strings.ContainsAny(s, " <>\n") {
+		return false
+	}
+	if idx := strings.Index(s, ":"); idx >= 2 && idx <= 32 {
+		return true
+	}
+	at := strings.IndexByte(s, '@')
+	return at > 0 && strings.Contains(s[at:], ".")
+}
+
+func findBacktickClose(text string, from int, length int) int {
+	for i := from; i < len(text); {
+		if text[i] != '`' {
+			i++
+			continue
+		}
+		run := countRun(text, i, '`')
+		if run == length {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
+func countRun(text string, start int, c byte) int {
+	n := 0
+	for start+n < len(text) && text[start+n] == c {
+		n++
+	}
+	return n
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isUnicodePunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
@@ -0,0 +1,191 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MarkdownTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MarkdownTestSuite) TestMarkdownToWhatsAppInline() {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"plain text is unchanged", "Hello there, how are you?", "Hello there, how are you?"},
+		{"strong with asterisks", "This is **bold** text", "This is *bold* text"},
+		{"strong with underscores", "This is __bold__ text", "This is *bold* text"},
+		{"emphasis with asterisk", "This is *italic* text", "This is _italic_ text"},
+		{"emphasis with underscore", "This is _italic_ text", "This is _italic_ text"},
+		{"strong and emphasis together", "***both***", "_*both*_"},
+		{"emphasis nested in strong", "**bold _and italic_ text**", "*bold _and italic_ text*"},
+		{"strong nested in emphasis", "*italic **and bold** text*", "_italic *and bold* text_"},
+		{"strong nested in emphasis at the end", "*italic **bold***", "_italic *bold*_"},
+		{"strikethrough", "~~gone~~ and ~also~", "~gone~ and ~also~"},
+		{"strike around strong", "~~**loud** removal~~", "~*loud* removal~"},
+		{"multiple spans on one line", "**a** and *b* and **c**", "*a* and _b_ and *c*"},
+		{"intraword underscores are literal", "call snake_case_name now", "call snake_case_name now"},
+		{"intraword asterisks emphasize", "un*frigging*believable", "un_frigging_believable"},
+		{"spaced asterisks are literal", "2 * 3 * 4 = 24", "2 * 3 * 4 = 24"},
+		{"unmatched delimiters are literal", "**not closed", "**not closed"},
+		{"escaped delimiters are literal", `\*not italic\* and \_no\_`, "*not italic* and _no_"},
+		{"inline code", "run `go test ./...` now", "run `go test ./...` now"},
+		{"inline code keeps markdown verbatim", "use `**kwargs` here", "use `**kwargs` here"},
+		{"double backtick code with backtick inside", "`` a`b ``", "```a`b```"},
+		{"link", "see [the docs](https://example.com/docs)", "see the docs (https://example.com/docs)"},
+		{"link with title", `[site](https://example.com "Example")`, "site (https://example.com)"},
+		{"link with emphasis in text", "[**Sale** now](https://shop.example.com)", "*Sale* now (https://shop.example.com)"},
+		{"link whose text is the url", "[https://example.com](https://example.com)", "https://example.com"},
+		{"link with parentheses in url", "[wiki](https://en.wikipedia.org/wiki/Go_(language))", "wiki (https://en.wikipedia.org/wiki/Go_(language))"},
+		{"autolink", "visit <https://example.com>", "visit https://example.com"},
+		{"email autolink", "mail <hi@example.com>", "mail hi@example.com"},
+		{"image", "![logo](https://example.com/logo.png)", "logo (https://example.com/logo.png)"},
+		{"brackets without url are literal", "pick [a] or [b]", "pick [a] or [b]"},
+		{"html-like text is kept", "a <b> c", "a <b> c"},
+		{"emphasis across lines of a paragraph", "**bold\ncontinues**", "*bold\ncontinues*"},
+		{"backslash hard break", "line one\\\nline two", "line one\nline two"},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.MarkdownToWhatsApp(tt.markdown))
+		})
+	}
+}
+
+func (suite *MarkdownTestSuite) TestMarkdownToWhatsAppBlocks() {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "headings become bold",
+			markdown: "# Title\n\n## Sub **title** ##\ntext",
+			want:     "*Title*\n\n*Sub title*\ntext",
+		},
+		{
+			name:     "setext headings",
+			markdown: "Title\n=====\nSection\n---",
+			want:     "*Title*\n*Section*",
+		},
+		{
+			name:     "fenced code block is verbatim",
+			markdown: "Example:\n\n```go\nfunc main() {\n\tfmt.Println(\"**hi**\")\n}\n```\n\nDone",
+			want:     "Example:\n\n```func main() {\n\tfmt.Println(\"**hi**\")\n}```\n\nDone",
+		},
+		{
+			name:     "tilde fence",
+			markdown: "~~~\n# not a heading\n~~~",
+			want:     "```# not a heading```",
+		},
+		{
+			name:     "unclosed fence runs to the end",
+			markdown: "```\nopen",
+			want:     "```open```",
+		},
+		{
+			name:     "indented code block",
+			markdown: "Run:\n\n    make build\n    make test\n\nthen",
+			want:     "Run:\n\n```make build\nmake test```\n\nthen",
+		},
+		{
+			name:     "block quote with inline formatting",
+			markdown: "> **Note:** be careful\n> second line",
+			want:     "> *Note:* be careful\n> second line",
+		},
+		{
+			name:     "nested block quotes are flattened",
+			markdown: "> outer\n>> inner",
+			want:     "> outer\n> inner",
+		},
+		{
+			name:     "block quote containing a list",
+			markdown: "> - one\n> - two",
+			want:     "> - one\n> - two",
+		},
+		{
+			name:     "bullet lists",
+			markdown: "* apples\n+ pears\n- **plums**",
+			want:     "- apples\n- pears\n- *plums*",
+		},
+		{
+			name:     "nested bullet list",
+			markdown: "- fruit\n  - apple\n    - green",
+			want:     "- fruit\n  - apple\n    - green",
+		},
+		{
+			name:     "ordered list keeps numbers",
+			markdown: "1. first\n2) second\n10. _tenth_",
+			want:     "1. first\n2. second\n10. _tenth_",
+		},
+		{
+			name:     "ordered list nested in bullet",
+			markdown: "- steps\n   1. open\n   2. close",
+			want:     "- steps\n  1. open\n  2. close",
+		},
+		{
+			name:     "list item continuation",
+			markdown: "1. first\n   more about **first**\n2. second",
+			want:     "1. first\n  more about *first*\n2. second",
+		},
+		{
+			name:     "ordered list not starting at one does not interrupt a paragraph",
+			markdown: "The year was\n1984. A good year",
+			want:     "The year was\n1984. A good year",
+		},
+		{
+			name:     "task list",
+			markdown: "- [ ] todo\n- [x] done",
+			want:     "- ☐ todo\n- ☑ done",
+		},
+		{
+			name:     "thematic breaks",
+			markdown: "above\n\n***\n\nbelow\n\n- - -",
+			want:     "above\n\n———\n\nbelow\n\n———",
+		},
+		{
+			name:     "emphasis line is not a list or break",
+			markdown: "**Summary**\n*quiet*",
+			want:     "*Summary*\n_quiet_",
+		},
+		{
+			name:     "blank lines are collapsed and trimmed",
+			markdown: "\n\none\n\n\n\ntwo\n\n",
+			want:     "one\n\ntwo",
+		},
+		{
+			name:     "windows line endings",
+			markdown: "**a**\r\n- b\r\n",
+			want:     "*a*\n- b",
+		},
+		{
+			name: "typical assistant reply",
+			markdown: "## Order status\n\nHi **Budi**, here is your order:\n\n" +
+				"1. **Nasi goreng** x2\n2. *Es teh* x1\n\n" +
+				"Track it at [our site](https://shop.example.com/track/42).\n\n> Paid with `QRIS`",
+			want: "*Order status*\n\nHi *Budi*, here is your order:\n\n" +
+				"1. *Nasi goreng* x2\n2. _Es teh_ x1\n\n" +
+				"Track it at our site (https://shop.example.com/track/42).\n\n> Paid with `QRIS`",
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.MarkdownToWhatsApp(tt.markdown))
+		})
+	}
+}
+
+func (suite *MarkdownTestSuite) TestFormatMessageText() {
+	assert.Equal(suite.T(), "*bold*", utils.FormatMessageText(utils.MessageFormatMarkdown, "**bold**"))
+	assert.Equal(suite.T(), "**bold**", utils.FormatMessageText("", "**bold**"))
+}
+
+func TestMarkdownTestSuite(t *testing.T) {
+	suite.Run(t, new(MarkdownTestSuite))
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// IsSupportedCountry reports whether region is a two-letter country code known to the phone number parser
+func IsSupportedCountry(region string) bool {
+	return phonenumbers.GetSupportedRegions()[strings.ToUpper(region)]
+}
+
+// NormalizePhoneNumber parses a phone number written in any common notation and returns it in E.164 form
+// without the leading "+", which is the user part of a WhatsApp JID.
+//
+// Numbers starting with "+" or "00" are international. Other numbers are first read as international
+// numbers without the "+" (e.g. 6281234567890), then as national numbers of config.WhatsappDefaultCountry,
+// which handles trunk prefixes (0812...) and the country's international prefix (011 44...).
+// Extensions are dropped because WhatsApp cannot dial them.
+func NormalizePhoneNumber(phone string) (string, error) {
+	raw := strings.TrimSpace(phone)
+	if raw == "" {
+		return "", pkgError.ValidationError("phone number is required")
+	}
+
+	region := strings.ToUpper(config.WhatsappDefaultCountry)
+	if region != "" && !IsSupportedCountry(region) {
+		return "", pkgError.ValidationError(fmt.Sprintf("default country %s is not supported", region))
+	}
+
+	var candidates []*phonenumbers.PhoneNumber
+	var parseErr error
+
+	international := raw
+	if !strings.HasPrefix(raw, "+") {
+		international = "+" + strings.TrimPrefix(raw, "00")
+	}
+	if number, err := phonenumbers.Parse(international, "ZZ"); err == nil {
+		candidates = append(candidates, number)
+	} else {
+		parseErr = err
+	}
+
+	if region != "" && !strings.HasPrefix(raw, "+") {
+		if number, err := phonenumbers.Parse(raw, region); err == nil {
+			candidates = append(candidates, number)
+		} else {
+			parseErr = err
+		}
+	}
+
+	// Prefer a number that matches the numbering plan, then one that only has a possible length
+	for _, number := range candidates {
+		if phonenumbers.IsValidNumber(number) {
+			return phonenumbers.Format(number, phonenumbers.E164)[1:], nil
+		}
+	}
+	for _, number := range candidates {
+		if phonenumbers.IsPossibleNumberWithReason(number) == phonenumbers.IS_POSSIBLE {
+			return phonenumbers.Format(number, phonenumbers.E164)[1:], nil
+		}
+	}
+
+	reason := phoneNumberParseReason(parseErr, region)
+	if len(candidates) > 0 {
+		reason = phoneNumberLengthReason(candidates[len(candidates)-1])
+	}
+	return "", pkgError.ValidationError(fmt.Sprintf("invalid phone number %s: %s", raw, reason))
+}
+
+// phoneNumberLengthReason explains why a parsed number does not fit the length rules of its country
+func phoneNumberLengthReason(number *phonenumbers.PhoneNumber) string {
+	region := phonenumbers.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+
+	switch phonenumbers.IsPossibleNumberWithReason(number) {
+	case phonenumbers.INVALID_COUNTRY_CODE:
+		return fmt.Sprintf("unknown country code %d", number.GetCountryCode())
+	case phonenumbers.TOO_SHORT:
+		return fmt.Sprintf("too short for country %s", region)
+	case phonenumbers.TOO_LONG:
+		return fmt.Sprintf("too long for country %s", region)
+	case phonenumbers.IS_POSSIBLE_LOCAL_ONLY:
+		return fmt.Sprintf("missing the area code for country %s", region)
+	default:
+		return fmt.Sprintf("invalid length for country %s", region)
+	}
+}
+
+// phoneNumberParseReason explains why the parser rejected a number
+func phoneNumberParseReason(err error, region string) string {
+	switch {
+	case errors.Is(err, phonenumbers.ErrInvalidCountryCode) && region == "":
+		return "unknown country code, include the country code or configure a default country"
+	case errors.Is(err, phonenumbers.ErrInvalidCountryCode):
+		return "unknown country code"
+	case errors.Is(err, phonenumbers.ErrTooShortNSN), errors.Is(err, phonenumbers.ErrTooShortAfterIDD):
+		return "too short"
+	case errors.Is(err, phonenumbers.ErrNumTooLong):
+		return "too long"
+	default:
+		return "not a phone number"
+	}
+}
@@ -0,0 +1,91 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type PhoneTestSuite struct {
+	suite.Suite
+	defaultCountry string
+}
+
+func (suite *PhoneTestSuite) SetupTest() {
+	suite.defaultCountry = config.WhatsappDefaultCountry
+}
+
+func (suite *PhoneTestSuite) TearDownTest() {
+	config.WhatsappDefaultCountry = suite.defaultCountry
+}
+
+func (suite *PhoneTestSuite) TestNormalizePhoneNumber() {
+	tests := []struct {
+		name    string
+		country string
+		phone   string
+		want    string
+		wantErr string
+	}{
+		{"international with plus", "", "+62 812-3456-7890", "6281234567890", ""},
+		{"international without plus", "", "6281234567890", "6281234567890", ""},
+		{"international prefix 00", "", "00 44 20 7946 0958", "442079460958", ""},
+		{"extension is dropped", "", "+1 415-555-2671 ext. 123", "14155552671", ""},
+		{"national trunk prefix", "ID", "0812-3456-7890", "6281234567890", ""},
+		{"national with formatting", "US", "(415) 555-2671", "14155552671", ""},
+		{"country international prefix", "US", "011 44 20 7946 0958", "442079460958", ""},
+		{"international number with default country", "US", "6281234567890", "6281234567890", ""},
+		{"national number without default country", "", "081234567890", "", "invalid phone number 081234567890: unknown country code, include the country code or configure a default country"},
+		{"too short", "", "+62 812", "", "invalid phone number +62 812: too short for country ID"},
+		{"too long", "", "+1 415 555 2671 999", "", "invalid phone number +1 415 555 2671 999: too long for country US"},
+		{"not a number", "ID", "hello", "", "invalid phone number hello: not a phone number"},
+		{"empty", "", " ", "", "phone number is required"},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			config.WhatsappDefaultCountry = tt.country
+			got, err := utils.NormalizePhoneNumber(tt.phone)
+			if tt.wantErr != "" {
+				assert.Equal(t, pkgError.ValidationError(tt.wantErr), err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func (suite *PhoneTestSuite) TestSanitizePhone() {
+	config.WhatsappDefaultCountry = "ID"
+
+	tests := []struct {
+		name  string
+		phone string
+		want  string
+	}{
+		{"national number", "0812 3456 7890", "6281234567890@s.whatsapp.net"},
+		{"international number", "+6281234567890", "6281234567890@s.whatsapp.net"},
+		{"user JID is kept", "6281234567890@s.whatsapp.net", "6281234567890@s.whatsapp.net"},
+		{"group ID", "120363024512399999", "120363024512399999@g.us"},
+		{"legacy group ID", "6281234567890-1600000000", "6281234567890-1600000000@g.us"},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			phone := tt.phone
+			assert.NoError(t, utils.SanitizePhone(&phone))
+			assert.Equal(t, tt.want, phone)
+		})
+	}
+
+	phone := "0812"
+	assert.Error(suite.T(), utils.SanitizePhone(&phone))
+	assert.Equal(suite.T(), "0812", phone)
+}
+
+func TestPhoneTestSuite(t *testing.T) {
+	suite.Run(t, new(PhoneTestSuite))
+}
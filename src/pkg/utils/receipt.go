@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"slices"
+
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+)
+
+// messageStatusOrder lists the delivery statuses of an outgoing message in the order a recipient reaches them
+var messageStatusOrder = []string{
+	domainChatStorage.MessageStatusSent,
+	domainChatStorage.MessageStatusDelivered,
+	domainChatStorage.MessageStatusRead,
+	domainChatStorage.MessageStatusPlayed,
+}
+
+// ReceiptStatus returns the furthest status one recipient reached
+func ReceiptStatus(receipt *domainChatStorage.MessageReceipt) string {
+	switch {
+	case receipt.PlayedAt != nil:
+		return domainChatStorage.MessageStatusPlayed
+	case receipt.ReadAt != nil:
+		return domainChatStorage.MessageStatusRead
+	case receipt.DeliveredAt != nil:
+		return domainChatStorage.MessageStatusDelivered
+	default:
+		return domainChatStorage.MessageStatusSent
+	}
+}
+
+// MessageStatus returns the status every one of the recipients of an outgoing message has reached, so a group
+// message only counts as delivered or read once all of its recipients got that far. Recipients without a receipt
+// count as sent. When the number of recipients is unknown (0) nobody can tell whether everyone read the message,
+// so the status stops at delivered.
+func MessageStatus(receipts []*domainChatStorage.MessageReceipt, recipients int) string {
+	if len(receipts) == 0 || len(receipts) < recipients {
+		return domainChatStorage.MessageStatusSent
+	}
+
+	lowest := len(messageStatusOrder) - 1
+	if recipients <= 0 {
+		lowest = slices.Index(messageStatusOrder, domainChatStorage.MessageStatusDelivered)
+	}
+	for _, receipt := range receipts {
+		lowest = min(lowest, slices.Index(messageStatusOrder, ReceiptStatus(receipt)))
+	}
+	return messageStatusOrder[lowest]
+}
@@ -0,0 +1,71 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReceiptTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ReceiptTestSuite) TestReceiptStatus() {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		receipt  *domainChatStorage.MessageReceipt
+		expected string
+	}{
+		{"no timestamps", &domainChatStorage.MessageReceipt{Participant: "a"}, domainChatStorage.MessageStatusSent},
+		{"delivered", &domainChatStorage.MessageReceipt{Participant: "a", DeliveredAt: &at}, domainChatStorage.MessageStatusDelivered},
+		{"read", &domainChatStorage.MessageReceipt{Participant: "a", DeliveredAt: &at, ReadAt: &at}, domainChatStorage.MessageStatusRead},
+		{"read without delivery receipt", &domainChatStorage.MessageReceipt{Participant: "a", ReadAt: &at}, domainChatStorage.MessageStatusRead},
+		{"played", &domainChatStorage.MessageReceipt{Participant: "a", DeliveredAt: &at, ReadAt: &at, PlayedAt: &at}, domainChatStorage.MessageStatusPlayed},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utils.ReceiptStatus(tt.receipt))
+		})
+	}
+}
+
+func (suite *ReceiptTestSuite) TestMessageStatus() {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	delivered := &domainChatStorage.MessageReceipt{Participant: "a", DeliveredAt: &at}
+	read := &domainChatStorage.MessageReceipt{Participant: "b", DeliveredAt: &at, ReadAt: &at}
+	played := &domainChatStorage.MessageReceipt{Participant: "c", DeliveredAt: &at, ReadAt: &at, PlayedAt: &at}
+
+	tests := []struct {
+		name       string
+		receipts   []*domainChatStorage.MessageReceipt
+		recipients int
+		expected   string
+	}{
+		{"no receipts", nil, 1, domainChatStorage.MessageStatusSent},
+		{"direct chat delivered", []*domainChatStorage.MessageReceipt{delivered}, 1, domainChatStorage.MessageStatusDelivered},
+		{"direct chat read", []*domainChatStorage.MessageReceipt{read}, 1, domainChatStorage.MessageStatusRead},
+		{"direct chat played", []*domainChatStorage.MessageReceipt{played}, 1, domainChatStorage.MessageStatusPlayed},
+		{"group with one of three read", []*domainChatStorage.MessageReceipt{read}, 3, domainChatStorage.MessageStatusSent},
+		{"group with two of three receipts", []*domainChatStorage.MessageReceipt{read, played}, 3, domainChatStorage.MessageStatusSent},
+		{"group with every receipt", []*domainChatStorage.MessageReceipt{delivered, read, played}, 3, domainChatStorage.MessageStatusDelivered},
+		{"group read by everyone", []*domainChatStorage.MessageReceipt{read, played}, 2, domainChatStorage.MessageStatusRead},
+		{"group with unknown participants", []*domainChatStorage.MessageReceipt{read, played}, 0, domainChatStorage.MessageStatusDelivered},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utils.MessageStatus(tt.receipts, tt.recipients))
+		})
+	}
+}
+
+func TestReceiptTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptTestSuite))
+}
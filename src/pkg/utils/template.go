@@ -0,0 +1,199 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode/utf8"
+)
+
+// templateFuncs is the function set available to message templates.
+// It only contains pure string helpers, so a template can never reach the filesystem, network or environment.
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"title": func(s string) string {
+		words := strings.Fields(s)
+		for i, word := range words {
+			r, size := utf8.DecodeRuneInString(word)
+			words[i] = strings.ToUpper(string(r)) + strings.ToLower(word[size:])
+		}
+		return strings.Join(words, " ")
+	},
+	"trim":    strings.TrimSpace,
+	"replace": func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"default": func(def string, value any) string {
+		if value == nil || fmt.Sprint(value) == "" {
+			return def
+		}
+		return fmt.Sprint(value)
+	},
+	"join": func(sep string, items []any) string {
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, sep)
+	},
+	"truncate": func(length int, s string) string {
+		if length < 0 || utf8.RuneCountInString(s) <= length {
+			return s
+		}
+		return string([]rune(s)[:length]) + "…"
+	},
+	"date": func(layout string) string { return time.Now().Format(layout) },
+}
+
+// ParseMessageTemplate parses a message template body with the safe function set
+func ParseMessageTemplate(body string) (*template.Template, error) {
+	return template.New("message").Funcs(templateFuncs).Option("missingkey=error").Parse(body)
+}
+
+// TemplateVariables returns the sorted names of the top-level variables referenced by a template body
+func TemplateVariables(body string) ([]string, error) {
+	references, err := templateVariableReferences(body)
+	if err != nil {
+		return nil, err
+	}
+	return sortedVariableNames(references, false), nil
+}
+
+// RequiredTemplateVariables returns the sorted names of the variables a template body always renders.
+// Variables only used in if, with or range blocks or passed through default are optional.
+func RequiredTemplateVariables(body string) ([]string, error) {
+	references, err := templateVariableReferences(body)
+	if err != nil {
+		return nil, err
+	}
+	return sortedVariableNames(references, true), nil
+}
+
+// templateVariableReferences maps every referenced variable to whether it is referenced unconditionally
+func templateVariableReferences(body string) (map[string]bool, error) {
+	tmpl, err := ParseMessageTemplate(body)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			collectTemplateVariables(t.Tree.Root, true, false, seen)
+		}
+	}
+	return seen, nil
+}
+
+func sortedVariableNames(references map[string]bool, requiredOnly bool) []string {
+	variables := make([]string, 0, len(references))
+	for name, required := range references {
+		if required || !requiredOnly {
+			variables = append(variables, name)
+		}
+	}
+	sort.Strings(variables)
+	return variables
+}
+
+// collectTemplateVariables walks a template tree; rootDot tells whether "." still refers to the variables map
+// and optional whether the current node only renders when a variable is set
+func collectTemplateVariables(node parse.Node, rootDot, optional bool, seen map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateVariables(child, rootDot, optional, seen)
+		}
+	case *parse.ActionNode:
+		collectTemplateVariables(n.Pipe, rootDot, optional, seen)
+	case *parse.TemplateNode:
+		collectTemplateVariables(n.Pipe, rootDot, optional, seen)
+	case *parse.IfNode:
+		collectTemplateVariables(n.Pipe, rootDot, true, seen)
+		collectTemplateVariables(n.List, rootDot, true, seen)
+		collectTemplateVariables(n.ElseList, rootDot, true, seen)
+	case *parse.RangeNode:
+		// Inside range and with, "." is rebound to the current element
+		collectTemplateVariables(n.Pipe, rootDot, true, seen)
+		collectTemplateVariables(n.List, false, true, seen)
+		collectTemplateVariables(n.ElseList, rootDot, true, seen)
+	case *parse.WithNode:
+		collectTemplateVariables(n.Pipe, rootDot, true, seen)
+		collectTemplateVariables(n.List, false, true, seen)
+		collectTemplateVariables(n.ElseList, rootDot, true, seen)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		// A default anywhere in the pipeline covers a missing value, e.g. {{ .nick | default "friend" }}
+		for _, cmd := range n.Cmds {
+			if len(cmd.Args) > 0 {
+				if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok && ident.Ident == "default" {
+					optional = true
+				}
+			}
+		}
+		for _, cmd := range n.Cmds {
+			collectTemplateVariables(cmd, rootDot, optional, seen)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectTemplateVariables(arg, rootDot, optional, seen)
+		}
+	case *parse.ChainNode:
+		collectTemplateVariables(n.Node, rootDot, optional, seen)
+	case *parse.FieldNode:
+		if rootDot && len(n.Ident) > 0 {
+			seen[n.Ident[0]] = seen[n.Ident[0]] || !optional
+		}
+	case *parse.VariableNode:
+		// $ always refers to the variables map, e.g. {{ $.name }}
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			seen[n.Ident[1]] = seen[n.Ident[1]] || !optional
+		}
+	}
+}
+
+// RenderMessageTemplate renders a template body, failing with the list of every required variable that was not
+// provided. Missing optional variables render as empty values, so {{ if .x }} and default behave as expected.
+func RenderMessageTemplate(body string, variables map[string]any) (string, error) {
+	required, err := RequiredTemplateVariables(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	var missing []string
+	for _, name := range required {
+		if _, ok := variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing template variables: %s", strings.Join(missing, ", "))
+	}
+
+	tmpl, err := ParseMessageTemplate(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	if variables == nil {
+		variables = map[string]any{}
+	}
+
+	// Only optional variables can still be missing here
+	tmpl.Option("missingkey=zero")
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, variables); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+
+	return buf.String(), nil
+}
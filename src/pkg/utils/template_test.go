@@ -0,0 +1,127 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TemplateTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TemplateTestSuite) TestTemplateVariables() {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"plain text has no variables", "Hello there", []string{}},
+		{"simple fields", "Hi {{.name}}, your order {{.order_id}} is ready", []string{"name", "order_id"}},
+		{"fields in functions and conditions", "{{upper .name}}{{if .vip}} ⭐{{end}}", []string{"name", "vip"}},
+		{"range body does not reference root", "{{range .items}}- {{.title}}\n{{end}}", []string{"items"}},
+		{"root variable inside range", "{{range .items}}{{$.currency}}{{.price}}{{end}}", []string{"currency", "items"}},
+		{"duplicates are reported once", "{{.a}} {{.a}} {{.b}}", []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			got, err := utils.TemplateVariables(tt.body)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := utils.TemplateVariables("{{.name")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TemplateTestSuite) TestRequiredTemplateVariables() {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"simple fields", "Hi {{.name}}, your order {{.order_id}} is ready", []string{"name", "order_id"}},
+		{"condition and its body are optional", "{{.name}}{{if .vip}} {{.tier}}{{else}} {{.plan}}{{end}}", []string{"name"}},
+		{"with and range are optional", "{{with .coupon}}{{.}}{{end}}{{range .items}}{{$.currency}}{{end}}", []string{}},
+		{"default covers a missing value", `{{.nick | default "friend"}} {{default "-" .note}}`, []string{}},
+		{"unconditional use wins", "{{if .name}}Hi{{end}} {{.name}}", []string{"name"}},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			got, err := utils.RequiredTemplateVariables(tt.body)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func (suite *TemplateTestSuite) TestRenderMessageTemplate() {
+	tests := []struct {
+		name        string
+		body        string
+		variables   map[string]any
+		want        string
+		errContains string
+	}{
+		{
+			name:      "renders variables",
+			body:      "Hi {{.name}}, total {{.total}}",
+			variables: map[string]any{"name": "Budi", "total": 15000},
+			want:      "Hi Budi, total 15000",
+		},
+		{
+			name:      "safe helpers",
+			body:      `{{upper .a}} {{lower .b}} {{title .c}} {{trim .d}} {{replace "-" " " .e}} {{truncate 3 .f}}`,
+			variables: map[string]any{"a": "x", "b": "Y", "c": "hello world", "d": "  z  ", "e": "a-b", "f": "abcdef"},
+			want:      "X y Hello World z a b abc…",
+		},
+		{
+			name:      "default and join",
+			body:      `{{.nick | default "friend"}}: {{join ", " .items}}`,
+			variables: map[string]any{"nick": "", "items": []any{"tea", "cake"}},
+			want:      "friend: tea, cake",
+		},
+		{
+			name:      "optional variables may be missing",
+			body:      `Hi {{.nick | default "friend"}}{{if .vip}}, VIP {{.tier}}{{end}}{{with .coupon}}, use {{.}}{{end}}`,
+			variables: map[string]any{},
+			want:      "Hi friend",
+		},
+		{
+			name:        "reports every missing variable",
+			body:        "Hi {{.name}}, order {{.order_id}} at {{.store}}",
+			variables:   map[string]any{"store": "Main"},
+			errContains: "missing template variables: name, order_id",
+		},
+		{
+			name:        "rejects invalid templates",
+			body:        "Hi {{.name",
+			variables:   map[string]any{},
+			errContains: "invalid template",
+		},
+		{
+			name:        "rejects unknown functions",
+			body:        `{{env "HOME"}}`,
+			variables:   map[string]any{},
+			errContains: "invalid template",
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			got, err := utils.RenderMessageTemplate(tt.body, tt.variables)
+			if tt.errContains != "" {
+				assert.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTemplateTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateTestSuite))
+}
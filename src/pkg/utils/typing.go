@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"math/rand/v2"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	typingCharsPerSecond    = 8
+	typingMinDuration       = time.Second
+	typingMaxDuration       = 15 * time.Second
+	recordingBytesPerSecond = 4000 // Voice notes are Opus at roughly 32 kbit/s
+	recordingMinDuration    = 2 * time.Second
+	recordingMaxDuration    = 30 * time.Second
+)
+
+// TypingDuration returns how long a person would take to type text, varied by up to 25% either way
+func TypingDuration(text string) time.Duration {
+	duration := time.Duration(utf8.RuneCountInString(text)) * time.Second / typingCharsPerSecond
+	return jitterDuration(duration, typingMinDuration, typingMaxDuration)
+}
+
+// RecordingDuration returns how long a person would take to record a voice note of size bytes,
+// varied by up to 25% either way
+func RecordingDuration(size uint64) time.Duration {
+	duration := time.Duration(size/recordingBytesPerSecond) * time.Second
+	return jitterDuration(duration, recordingMinDuration, recordingMaxDuration)
+}
+
+func jitterDuration(duration, minDuration, maxDuration time.Duration) time.Duration {
+	duration = min(max(duration, minDuration), maxDuration)
+	duration += time.Duration(rand.Int64N(int64(duration/2)+1)) - duration/4
+	return min(max(duration, minDuration), maxDuration)
+}
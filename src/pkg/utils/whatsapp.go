@@ -19,6 +19,7 @@ import (
 	"go.mau.fi/whatsmeow/types/events"
 
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	"go.mau.fi/whatsmeow"
 )
@@ -133,6 +134,13 @@ func ExtractMessageTextFromEvent(evt *events.Message) string {
 		} else {
 			messageText = "👤 " + messageText
 		}
+	} else if groupInviteMessage := evt.Message.GetGroupInviteMessage(); groupInviteMessage != nil {
+		messageText = groupInviteMessage.GetGroupName()
+		if messageText == "" {
+			messageText = "👥 Group Invite"
+		} else {
+			messageText = "👥 " + messageText
+		}
 	} else if listMessage := evt.Message.GetListMessage(); listMessage != nil {
 		messageText = listMessage.GetTitle()
 		if messageText == "" {
@@ -452,6 +460,19 @@ func ParseJID(arg string) (types.JID, error) {
 	return recipient, nil
 }
 
+// ParseNewsletterInviteCode extracts the invite code from a channel invite link such as
+// https://whatsapp.com/channel/0029VaXXXX, a bare invite code is returned unchanged
+func ParseNewsletterInviteCode(link string) string {
+	code := strings.TrimSpace(link)
+	if idx := strings.Index(code, "/channel/"); idx != -1 {
+		code = code[idx+len("/channel/"):]
+	}
+	if idx := strings.IndexAny(code, "/?#"); idx != -1 {
+		code = code[:idx]
+	}
+	return code
+}
+
 // FormatJID formats a JID string by removing any :number suffix
 func FormatJID(jid string) types.JID {
 	// Remove any :number suffix if present
@@ -521,31 +542,47 @@ func ExtractMedia(ctx context.Context, client *whatsmeow.Client, storageLocation
 	return extractedMedia, nil
 }
 
-// SanitizePhone sanitizes phone number by adding appropriate WhatsApp suffix
 const maxPhoneNumberLength = 15 // Maximum digits in a phone number
 
-func SanitizePhone(phone *string) {
-	if phone != nil && len(*phone) > 0 && !strings.Contains(*phone, "@") {
-		if len(*phone) <= maxPhoneNumberLength {
-			*phone = fmt.Sprintf("%s%s", *phone, config.WhatsappTypeUser)
-		} else {
-			*phone = fmt.Sprintf("%s%s", *phone, config.WhatsappTypeGroup)
+// SanitizePhone turns a phone number into a user JID, normalizing it to E.164 first,
+// or a bare group ID into a group JID. Values that already are JIDs are left unchanged.
+func SanitizePhone(phone *string) error {
+	if phone == nil || len(*phone) == 0 || strings.Contains(*phone, "@") {
+		return nil
+	}
+
+	digits := 0
+	for _, r := range *phone {
+		if r >= '0' && r <= '9' {
+			digits++
 		}
 	}
+	if digits > maxPhoneNumberLength {
+		*phone = fmt.Sprintf("%s%s", *phone, config.WhatsappTypeGroup)
+		return nil
+	}
+
+	normalized, err := NormalizePhoneNumber(*phone)
+	if err != nil {
+		return err
+	}
+	*phone = fmt.Sprintf("%s%s", normalized, config.WhatsappTypeUser)
+	return nil
 }
 
-// IsOnWhatsapp checks if a number is registered on WhatsApp
+// IsOnWhatsapp checks if a number is registered on WhatsApp, answering from the check cache when possible
 func IsOnWhatsapp(client *whatsmeow.Client, jid string) bool {
 	// only check if the jid a user with @s.whatsapp.net
 	if strings.Contains(jid, "@s.whatsapp.net") {
-		data, err := client.IsOnWhatsApp([]string{jid})
+		phone := strings.Split(strings.Split(jid, "@")[0], ":")[0]
+		results, err := CheckOnWhatsApp(client, []string{phone})
 		if err != nil {
 			logrus.Error("Failed to check if user is on whatsapp: ", err)
 			return false
 		}
 
-		for _, v := range data {
-			if !v.IsIn {
+		for _, v := range results {
+			if !v.IsOnWhatsApp {
 				return false
 			}
 		}
@@ -554,10 +591,114 @@ func IsOnWhatsapp(client *whatsmeow.Client, jid string) bool {
 	return true
 }
 
+// WhatsAppCheckCache stores registration checks so repeated lookups of a number skip the network
+type WhatsAppCheckCache interface {
+	GetWhatsAppChecks(phones []string, checkedAfter time.Time) (map[string]*domainChatStorage.WhatsAppCheck, error)
+	StoreWhatsAppChecks(checks []*domainChatStorage.WhatsAppCheck) error
+}
+
+var whatsAppCheckCache WhatsAppCheckCache
+
+// SetWhatsAppCheckCache sets where registration checks are cached, nil disables the cache
+func SetWhatsAppCheckCache(cache WhatsAppCheckCache) {
+	whatsAppCheckCache = cache
+}
+
+// WhatsAppCheckResult is the registration check of one phone number
+type WhatsAppCheckResult struct {
+	domainChatStorage.WhatsAppCheck
+	Cached bool
+}
+
+// whatsAppCheckBatchSize is how many numbers are sent to WhatsApp in one query
+const whatsAppCheckBatchSize = 100
+
+// CheckOnWhatsApp checks which phone numbers (digits with country code) are registered on WhatsApp. Checks made
+// within WhatsappCheckCacheTTL are answered from the cache, the remaining numbers are queried in batches and cached.
+// Results are returned in the order of phones.
+func CheckOnWhatsApp(client *whatsmeow.Client, phones []string) ([]WhatsAppCheckResult, error) {
+	useCache := whatsAppCheckCache != nil && config.WhatsappCheckCacheTTL > 0
+
+	cached := make(map[string]*domainChatStorage.WhatsAppCheck)
+	if useCache {
+		var err error
+		cached, err = whatsAppCheckCache.GetWhatsAppChecks(phones, time.Now().Add(-config.WhatsappCheckCacheTTL))
+		if err != nil {
+			logrus.Warnf("Failed to read WhatsApp check cache: %v", err)
+			cached = make(map[string]*domainChatStorage.WhatsAppCheck)
+		}
+	}
+
+	var missing []string
+	for _, phone := range phones {
+		if cached[phone] == nil {
+			missing = append(missing, phone)
+		}
+	}
+
+	fresh := make(map[string]*domainChatStorage.WhatsAppCheck, len(missing))
+	for start := 0; start < len(missing); start += whatsAppCheckBatchSize {
+		batch := missing[start:min(start+whatsAppCheckBatchSize, len(missing))]
+
+		queries := make([]string, len(batch))
+		for i, phone := range batch {
+			queries[i] = "+" + phone
+		}
+
+		responses, err := client.IsOnWhatsApp(queries)
+		if err != nil {
+			return nil, err
+		}
+
+		// Numbers missing from the answer are not registered
+		checkedAt := time.Now()
+		checks := make([]*domainChatStorage.WhatsAppCheck, 0, len(batch))
+		for _, phone := range batch {
+			fresh[phone] = &domainChatStorage.WhatsAppCheck{Phone: phone, CheckedAt: checkedAt}
+			checks = append(checks, fresh[phone])
+		}
+		for _, response := range responses {
+			check := fresh[strings.TrimPrefix(response.Query, "+")]
+			if check == nil {
+				continue
+			}
+			check.IsOnWhatsApp = response.IsIn
+			if response.IsIn {
+				check.JID = response.JID.String()
+			}
+			if response.VerifiedName != nil {
+				check.IsBusiness = true
+				check.VerifiedName = response.VerifiedName.Details.GetVerifiedName()
+			}
+		}
+
+		if useCache {
+			if err := whatsAppCheckCache.StoreWhatsAppChecks(checks); err != nil {
+				logrus.Warnf("Failed to store WhatsApp checks in cache: %v", err)
+			}
+		}
+	}
+
+	results := make([]WhatsAppCheckResult, 0, len(phones))
+	for _, phone := range phones {
+		if check := cached[phone]; check != nil {
+			results = append(results, WhatsAppCheckResult{WhatsAppCheck: *check, Cached: true})
+		} else {
+			results = append(results, WhatsAppCheckResult{WhatsAppCheck: *fresh[phone]})
+		}
+	}
+
+	return results, nil
+}
+
 // ValidateJidWithLogin validates JID with login check
 func ValidateJidWithLogin(client *whatsmeow.Client, jid string) (types.JID, error) {
 	MustLogin(client)
 
+	if err := SanitizePhone(&jid); err != nil {
+		return types.JID{}, err
+	}
+
 	if config.WhatsappAccountValidation && !IsOnWhatsapp(client, jid) {
 		return types.JID{}, pkgError.InvalidJID(fmt.Sprintf("Phone %s is not on whatsapp", jid))
 	}
@@ -3,8 +3,10 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	domainChat "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chat"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -22,9 +24,13 @@ func InitMcpChat(chatService domainChat.IChatUsecase) *ChatHandler {
 func (c *ChatHandler) AddChatTools(mcpServer *server.MCPServer) {
 	mcpServer.AddTool(c.toolGetList(), c.handleGetList)
 	mcpServer.AddTool(c.toolGetMessages(), c.handleGetMessages)
+	mcpServer.AddTool(c.toolGetPinnedMessages(), c.handleGetPinnedMessages)
 	mcpServer.AddTool(c.toolArchive(), c.handleArchive)
 	mcpServer.AddTool(c.toolMarkAsRead(), c.handleMarkAsRead)
 	mcpServer.AddTool(c.toolDeleteChat(), c.handleDeleteChat)
+	mcpServer.AddTool(c.toolSetDisappearingTimer(), c.handleSetDisappearingTimer)
+	mcpServer.AddTool(c.toolSetDefaultDisappearingTimer(), c.handleSetDefaultDisappearingTimer)
+	mcpServer.AddTool(c.toolListCalls(), c.handleListCalls)
 }
 
 func (c *ChatHandler) toolGetList() mcp.Tool {
@@ -181,6 +187,62 @@ func (c *ChatHandler) handleDeleteChat(ctx context.Context, request mcp.CallTool
 	return mcp.NewToolResultText(resp.Message), nil
 }
 
+func (c *ChatHandler) toolSetDisappearingTimer() mcp.Tool {
+	return mcp.NewTool("whatsapp_set_disappearing_timer",
+		mcp.WithDescription("Set the disappearing messages timer of a chat or group."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or group ID"),
+		),
+		mcp.WithString("timer",
+			mcp.Required(),
+			mcp.Description("Timer: off, 24h, 7d or 90d"),
+			mcp.Enum("off", "24h", "7d", "90d"),
+		),
+	)
+}
+
+func (c *ChatHandler) handleSetDisappearingTimer(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+	timer := request.GetArguments()["timer"].(string)
+
+	resp, err := c.chatService.SetDisappearingTimer(ctx, domainChat.SetDisappearingTimerRequest{
+		ChatJID: phone,
+		Timer:   timer,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to set disappearing timer: %w", err)
+	}
+
+	return mcp.NewToolResultText(resp.Message), nil
+}
+
+func (c *ChatHandler) toolSetDefaultDisappearingTimer() mcp.Tool {
+	return mcp.NewTool("whatsapp_set_default_disappearing_timer",
+		mcp.WithDescription("Set the account-wide default disappearing messages timer applied to new chats."),
+		mcp.WithString("timer",
+			mcp.Required(),
+			mcp.Description("Timer: off, 24h, 7d or 90d"),
+			mcp.Enum("off", "24h", "7d", "90d"),
+		),
+	)
+}
+
+func (c *ChatHandler) handleSetDefaultDisappearingTimer(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	timer := request.GetArguments()["timer"].(string)
+
+	resp, err := c.chatService.SetDefaultDisappearingTimer(ctx, domainChat.SetDefaultDisappearingTimerRequest{
+		Timer: timer,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default disappearing timer: %w", err)
+	}
+
+	return mcp.NewToolResultText(resp.Message), nil
+}
+
 func (c *ChatHandler) toolGetMessages() mcp.Tool {
 	return mcp.NewTool("whatsapp_get_messages",
 		mcp.WithDescription("Get recent messages from a chat."),
@@ -222,7 +284,14 @@ func (c *ChatHandler) handleGetMessages(ctx context.Context, request mcp.CallToo
 		if msg.IsFromMe {
 			sender = "Me"
 		}
-		result += fmt.Sprintf("%d. [%s] %s: %s\n", i+1, msg.Timestamp, sender, msg.Content)
+		suffix := ""
+		if msg.IsEdited {
+			suffix = " (edited)"
+		}
+		if msg.Status != "" {
+			suffix += fmt.Sprintf(" [%s]", msg.Status)
+		}
+		result += fmt.Sprintf("%d. [%s] %s: %s%s\n", i+1, msg.Timestamp, sender, msg.Content, suffix)
 		if msg.MediaType != "" && msg.MediaType != "text" {
 			result += fmt.Sprintf("   Type: %s", msg.MediaType)
 			if msg.Filename != "" {
@@ -230,7 +299,106 @@ func (c *ChatHandler) handleGetMessages(ctx context.Context, request mcp.CallToo
 			}
 			result += "\n"
 		}
+		if len(msg.Reactions) > 0 {
+			reactions := make([]string, 0, len(msg.Reactions))
+			for _, reaction := range msg.Reactions {
+				reactions = append(reactions, fmt.Sprintf("%s %d", reaction.Emoji, reaction.Count))
+			}
+			result += fmt.Sprintf("   Reactions: %s\n", strings.Join(reactions, ", "))
+		}
 	}
 	
 	return mcp.NewToolResultText(result), nil
-}
\ No newline at end of file
+}
+
+func (c *ChatHandler) toolGetPinnedMessages() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_pinned_messages",
+		mcp.WithDescription("Get the messages currently pinned in a chat or group."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or group ID"),
+		),
+	)
+}
+
+func (c *ChatHandler) handleGetPinnedMessages(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+
+	response, err := c.chatService.GetPinnedMessages(ctx, domainChat.GetPinnedMessagesRequest{
+		ChatJID: phone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned messages: %w", err)
+	}
+
+	if len(response.Data) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No pinned messages in chat %s", phone)), nil
+	}
+
+	result := fmt.Sprintf("Pinned messages in %s (%d):\n", phone, len(response.Data))
+	for i, pin := range response.Data {
+		content := pin.Content
+		if content == "" {
+			content = "(message not stored)"
+		}
+		result += fmt.Sprintf("%d. [%s] %s (pinned by %s until %s)\n", i+1, pin.MessageID, content, pin.PinnedBy, pin.ExpiresAt)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (c *ChatHandler) toolListCalls() mcp.Tool {
+	return mcp.NewTool("whatsapp_list_calls",
+		mcp.WithDescription("Get the log of incoming calls, newest first, including missed and automatically rejected calls."),
+		mcp.WithString("phone",
+			mcp.Description("Only return calls from this phone number (optional)"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of calls to return (default: 25)"),
+		),
+	)
+}
+
+func (c *ChatHandler) handleListCalls(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone, _ := request.GetArguments()["phone"].(string)
+	if phone != "" {
+		if err := utils.SanitizePhone(&phone); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := 25
+	if l, ok := request.GetArguments()["limit"].(float64); ok {
+		limit = int(l)
+	}
+
+	response, err := c.chatService.ListCalls(ctx, domainChat.ListCallsRequest{
+		Phone: phone,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call log: %w", err)
+	}
+
+	if len(response.Data) == 0 {
+		return mcp.NewToolResultText("No calls found"), nil
+	}
+
+	result := fmt.Sprintf("Found %d calls:\n", response.Pagination.Total)
+	for i, call := range response.Data {
+		kind := "voice"
+		if call.IsVideo {
+			kind = "video"
+		}
+		if call.IsGroup {
+			kind = "group " + kind
+		}
+		status := call.Status
+		if call.AutoRejected {
+			status += " (automatically)"
+		}
+		result += fmt.Sprintf("%d. %s call from %s at %s: %s\n", i+1, kind, call.From, call.StartedAt, status)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
@@ -26,6 +26,7 @@ func (g *GroupHandler) AddGroupTools(mcpServer *server.MCPServer) {
 	mcpServer.AddTool(g.toolLeaveGroup(), g.handleLeaveGroup)
 	mcpServer.AddTool(g.toolGetGroupInfo(), g.handleGetGroupInfo)
 	mcpServer.AddTool(g.toolJoinWithLink(), g.handleJoinWithLink)
+	mcpServer.AddTool(g.toolAcceptGroupInvite(), g.handleAcceptGroupInvite)
 	mcpServer.AddTool(g.toolGetInviteLink(), g.handleGetInviteLink)
 	
 	// Group settings
@@ -33,36 +34,60 @@ func (g *GroupHandler) AddGroupTools(mcpServer *server.MCPServer) {
 	mcpServer.AddTool(g.toolSetGroupLocked(), g.handleSetGroupLocked)
 	mcpServer.AddTool(g.toolSetGroupAnnounce(), g.handleSetGroupAnnounce)
 	mcpServer.AddTool(g.toolSetGroupTopic(), g.handleSetGroupTopic)
+	mcpServer.AddTool(g.toolSetGroupJoinApproval(), g.handleSetGroupJoinApproval)
+	mcpServer.AddTool(g.toolSetGroupMemberAddMode(), g.handleSetGroupMemberAddMode)
+	mcpServer.AddTool(g.toolSetGroupEphemeral(), g.handleSetGroupEphemeral)
+	mcpServer.AddTool(g.toolRevokeInviteLink(), g.handleRevokeInviteLink)
 	
 	// Participant management
 	mcpServer.AddTool(g.toolAddParticipants(), g.handleAddParticipants)
 	mcpServer.AddTool(g.toolRemoveParticipants(), g.handleRemoveParticipants)
 	mcpServer.AddTool(g.toolPromoteAdmin(), g.handlePromoteAdmin)
 	mcpServer.AddTool(g.toolDemoteAdmin(), g.handleDemoteAdmin)
+	mcpServer.AddTool(g.toolBulkAddParticipants(), g.handleBulkAddParticipants)
 	
 	// Advanced group features
 	mcpServer.AddTool(g.toolGetGroupInfoFromLink(), g.handleGetGroupInfoFromLink)
 	mcpServer.AddTool(g.toolGetGroupRequestParticipants(), g.handleGetGroupRequestParticipants)
 	mcpServer.AddTool(g.toolManageGroupRequestParticipants(), g.handleManageGroupRequestParticipants)
+
+	// Communities
+	mcpServer.AddTool(g.toolLinkCommunityGroup(), g.handleLinkCommunityGroup)
+	mcpServer.AddTool(g.toolUnlinkCommunityGroup(), g.handleUnlinkCommunityGroup)
+	mcpServer.AddTool(g.toolGetCommunitySubGroups(), g.handleGetCommunitySubGroups)
+	mcpServer.AddTool(g.toolGetCommunityParticipants(), g.handleGetCommunityParticipants)
+	mcpServer.AddTool(g.toolSendCommunityAnnouncement(), g.handleSendCommunityAnnouncement)
+
+	// Membership history
+	mcpServer.AddTool(g.toolGetGroupMembership(), g.handleGetGroupMembership)
+	mcpServer.AddTool(g.toolGetGroupGrowth(), g.handleGetGroupGrowth)
+	mcpServer.AddTool(g.toolGetGroupChurn(), g.handleGetGroupChurn)
 }
 
 func (g *GroupHandler) toolCreateGroup() mcp.Tool {
 	return mcp.NewTool("whatsapp_create_group",
-		mcp.WithDescription("Create a new WhatsApp group."),
+		mcp.WithDescription("Create a new WhatsApp group, a community, or a group inside a community."),
 		mcp.WithString("name",
 			mcp.Required(),
 			mcp.Description("Group name"),
 		),
 		mcp.WithArray("participants",
-			mcp.Required(),
-			mcp.Description("Array of phone numbers to add as participants"),
+			mcp.Description("Array of phone numbers to add as participants (required unless creating a community)"),
+		),
+		mcp.WithBoolean("is_community",
+			mcp.Description("Create a community instead of a normal group (default: false)"),
+		),
+		mcp.WithString("community_id",
+			mcp.Description("Create the group inside this community"),
 		),
 	)
 }
 
 func (g *GroupHandler) handleCreateGroup(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	name := request.GetArguments()["name"].(string)
-	participantsRaw := request.GetArguments()["participants"].([]interface{})
+	participantsRaw, _ := request.GetArguments()["participants"].([]interface{})
+	isCommunity, _ := request.GetArguments()["is_community"].(bool)
+	communityID, _ := request.GetArguments()["community_id"].(string)
 	
 	participants := make([]string, len(participantsRaw))
 	for i, p := range participantsRaw {
@@ -72,6 +97,8 @@ func (g *GroupHandler) handleCreateGroup(ctx context.Context, request mcp.CallTo
 	groupID, err := g.groupService.CreateGroup(ctx, domainGroup.CreateGroupRequest{
 		Title:        name,
 		Participants: participants,
+		IsCommunity:  isCommunity,
+		CommunityID:  communityID,
 	})
 	
 	if err != nil {
@@ -128,6 +155,15 @@ func (g *GroupHandler) handleGetGroupInfo(ctx context.Context, request mcp.CallT
 
 	// The response.Data contains the actual group info
 	result := fmt.Sprintf("Group Info:\n%+v", response.Data)
+	if response.IsParent {
+		result += "\nThis group is a community"
+	}
+	if response.LinkedParentJID != "" {
+		result += fmt.Sprintf("\nLinked to community: %s", response.LinkedParentJID)
+	}
+	if response.IsDefaultSubGroup {
+		result += "\nThis is the announcement group of its community"
+	}
 	return mcp.NewToolResultText(result), nil
 }
 
@@ -155,6 +191,46 @@ func (g *GroupHandler) handleJoinWithLink(ctx context.Context, request mcp.CallT
 	return mcp.NewToolResultText(fmt.Sprintf("Successfully joined group: %s", groupID)), nil
 }
 
+func (g *GroupHandler) toolAcceptGroupInvite() mcp.Tool {
+	return mcp.NewTool("whatsapp_accept_group_invite",
+		mcp.WithDescription("Join a group from a received group invite message, using the group_invite details of the message webhook."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID from the invite"),
+		),
+		mcp.WithString("inviter",
+			mcp.Required(),
+			mcp.Description("JID of the user who sent the invite"),
+		),
+		mcp.WithString("invite_code",
+			mcp.Required(),
+			mcp.Description("Invite code from the invite"),
+		),
+		mcp.WithNumber("invite_expiration",
+			mcp.Description("Invite expiration as unix seconds, omit for cards that share an invite link"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleAcceptGroupInvite(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	inviter := request.GetArguments()["inviter"].(string)
+	inviteCode := request.GetArguments()["invite_code"].(string)
+	inviteExpiration, _ := request.GetArguments()["invite_expiration"].(float64)
+
+	joinedGroupID, err := g.groupService.AcceptGroupInvite(ctx, domainGroup.AcceptGroupInviteRequest{
+		GroupID:          groupID,
+		Inviter:          inviter,
+		InviteCode:       inviteCode,
+		InviteExpiration: int64(inviteExpiration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully joined group: %s", joinedGroupID)), nil
+}
+
 func (g *GroupHandler) toolGetInviteLink() mcp.Tool {
 	return mcp.NewTool("whatsapp_get_invite_link",
 		mcp.WithDescription("Get invite link for a WhatsApp group."),
@@ -603,4 +679,460 @@ func (g *GroupHandler) handleManageGroupRequestParticipants(ctx context.Context,
 	}
 	
 	return mcp.NewToolResultText(response), nil
-}
\ No newline at end of file
+}
+func (g *GroupHandler) toolSetGroupJoinApproval() mcp.Tool {
+	return mcp.NewTool("whatsapp_set_group_join_approval",
+		mcp.WithDescription("Require or stop requiring admin approval for new members joining a group."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID/JID"),
+		),
+		mcp.WithBoolean("require_approval",
+			mcp.Required(),
+			mcp.Description("True to require admin approval, false to let anyone with the link join"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleSetGroupJoinApproval(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	requireApproval := request.GetArguments()["require_approval"].(bool)
+
+	err := g.groupService.SetGroupJoinApproval(ctx, domainGroup.SetGroupJoinApprovalRequest{
+		GroupID:         groupID,
+		RequireApproval: requireApproval,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status := "disabled"
+	if requireApproval {
+		status = "enabled"
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Group join approval %s", status)), nil
+}
+
+func (g *GroupHandler) toolSetGroupMemberAddMode() mcp.Tool {
+	return mcp.NewTool("whatsapp_set_group_member_add_mode",
+		mcp.WithDescription("Choose whether only admins or all members can add participants to a group."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID/JID"),
+		),
+		mcp.WithString("mode",
+			mcp.Required(),
+			mcp.Description("admin_add for admins only, all_member_add for everyone"),
+			mcp.Enum("admin_add", "all_member_add"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleSetGroupMemberAddMode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	mode := request.GetArguments()["mode"].(string)
+
+	err := g.groupService.SetGroupMemberAddMode(ctx, domainGroup.SetGroupMemberAddModeRequest{
+		GroupID: groupID,
+		Mode:    mode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Group member add mode set to %s", mode)), nil
+}
+
+func (g *GroupHandler) toolSetGroupEphemeral() mcp.Tool {
+	return mcp.NewTool("whatsapp_set_group_ephemeral",
+		mcp.WithDescription("Set the disappearing messages timer of a group."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID/JID"),
+		),
+		mcp.WithString("timer",
+			mcp.Required(),
+			mcp.Description("Disappearing timer: off, 24h, 7d or 90d"),
+			mcp.Enum("off", "24h", "7d", "90d"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleSetGroupEphemeral(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	timer := request.GetArguments()["timer"].(string)
+
+	err := g.groupService.SetGroupEphemeral(ctx, domainGroup.SetGroupEphemeralRequest{
+		GroupID: groupID,
+		Timer:   timer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Group disappearing messages set to %s", timer)), nil
+}
+
+func (g *GroupHandler) toolRevokeInviteLink() mcp.Tool {
+	return mcp.NewTool("whatsapp_revoke_invite_link",
+		mcp.WithDescription("Revoke the current invite link of a group and get a new one."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID/JID"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleRevokeInviteLink(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+
+	response, err := g.groupService.RevokeGroupInviteLink(ctx, domainGroup.RevokeGroupInviteLinkRequest{
+		GroupID: groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Invite link revoked. New invite link: %s", response.InviteLink)), nil
+}
+
+func (g *GroupHandler) toolLinkCommunityGroup() mcp.Tool {
+	return mcp.NewTool("whatsapp_link_community_group",
+		mcp.WithDescription("Link an existing group to a community."),
+		mcp.WithString("community_id",
+			mcp.Required(),
+			mcp.Description("Community ID"),
+		),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID to link"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleLinkCommunityGroup(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	communityID := request.GetArguments()["community_id"].(string)
+	groupID := request.GetArguments()["group_id"].(string)
+
+	err := g.groupService.LinkGroup(ctx, domainGroup.LinkCommunityGroupRequest{
+		CommunityID: communityID,
+		GroupID:     groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Group %s linked to community %s", groupID, communityID)), nil
+}
+
+func (g *GroupHandler) toolUnlinkCommunityGroup() mcp.Tool {
+	return mcp.NewTool("whatsapp_unlink_community_group",
+		mcp.WithDescription("Unlink a group from a community."),
+		mcp.WithString("community_id",
+			mcp.Required(),
+			mcp.Description("Community ID"),
+		),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID to unlink"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleUnlinkCommunityGroup(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	communityID := request.GetArguments()["community_id"].(string)
+	groupID := request.GetArguments()["group_id"].(string)
+
+	err := g.groupService.UnlinkGroup(ctx, domainGroup.LinkCommunityGroupRequest{
+		CommunityID: communityID,
+		GroupID:     groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Group %s unlinked from community %s", groupID, communityID)), nil
+}
+
+func (g *GroupHandler) toolGetCommunitySubGroups() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_community_subgroups",
+		mcp.WithDescription("List the groups linked to a community, including its announcement group."),
+		mcp.WithString("community_id",
+			mcp.Required(),
+			mcp.Description("Community ID"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleGetCommunitySubGroups(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	communityID := request.GetArguments()["community_id"].(string)
+
+	subGroups, err := g.groupService.GetSubGroups(ctx, domainGroup.CommunityRequest{
+		CommunityID: communityID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subGroups) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No groups linked to community %s", communityID)), nil
+	}
+
+	result := fmt.Sprintf("Community %s has %d groups:\n", communityID, len(subGroups))
+	for _, subGroup := range subGroups {
+		result += fmt.Sprintf("- %s (%s)", subGroup.Name, subGroup.JID)
+		if subGroup.IsDefaultSubGroup {
+			result += " [announcement group]"
+		}
+		result += "\n"
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (g *GroupHandler) toolGetCommunityParticipants() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_community_participants",
+		mcp.WithDescription("List the participants across all groups linked to a community."),
+		mcp.WithString("community_id",
+			mcp.Required(),
+			mcp.Description("Community ID"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleGetCommunityParticipants(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	communityID := request.GetArguments()["community_id"].(string)
+
+	response, err := g.groupService.GetCommunityParticipants(ctx, domainGroup.CommunityRequest{
+		CommunityID: communityID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Community %s has %d participants:\n", response.CommunityID, len(response.Participants))
+	for _, participant := range response.Participants {
+		result += fmt.Sprintf("- %s\n", participant)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (g *GroupHandler) toolSendCommunityAnnouncement() mcp.Tool {
+	return mcp.NewTool("whatsapp_send_community_announcement",
+		mcp.WithDescription("Send a text message to the announcement group of a community."),
+		mcp.WithString("community_id",
+			mcp.Required(),
+			mcp.Description("Community ID"),
+		),
+		mcp.WithString("message",
+			mcp.Required(),
+			mcp.Description("Announcement text"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleSendCommunityAnnouncement(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	communityID := request.GetArguments()["community_id"].(string)
+	message := request.GetArguments()["message"].(string)
+
+	response, err := g.groupService.SendCommunityAnnouncement(ctx, domainGroup.CommunityAnnouncementRequest{
+		CommunityID: communityID,
+		Message:     message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Announcement sent to %s with ID %s", response.GroupID, response.MessageID)), nil
+}
+
+func (g *GroupHandler) toolGetGroupMembership() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_group_membership",
+		mcp.WithDescription("List the members of a group at a point in time from the stored membership history, including who added each member."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID"),
+		),
+		mcp.WithString("at",
+			mcp.Description("Point in time in RFC3339 format (default: now)"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleGetGroupMembership(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	at, _ := request.GetArguments()["at"].(string)
+
+	response, err := g.groupService.GetGroupMembership(ctx, domainGroup.GroupMembershipRequest{
+		GroupID: groupID,
+		At:      at,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.TrackedSince == "" {
+		return mcp.NewToolResultText(fmt.Sprintf("No membership history stored for group %s", response.GroupID)), nil
+	}
+
+	result := fmt.Sprintf("Group %s had %d members at %s (tracked since %s):\n", response.GroupID, response.Total, response.At, response.TrackedSince)
+	for _, member := range response.Members {
+		result += fmt.Sprintf("- %s since %s (%s", member.JID, member.Since, member.Source)
+		if member.AddedBy != "" {
+			result += fmt.Sprintf(" by %s", member.AddedBy)
+		}
+		result += ")"
+		if member.IsAdmin {
+			result += " [admin]"
+		}
+		result += "\n"
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (g *GroupHandler) toolGetGroupGrowth() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_group_growth",
+		mcp.WithDescription("Report how a group's membership grew over a period, with a daily breakdown of joins and leaves."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID"),
+		),
+		mcp.WithString("from",
+			mcp.Description("Start of the period in RFC3339 format (default: 30 days before to, at most 366 days before to)"),
+		),
+		mcp.WithString("to",
+			mcp.Description("End of the period in RFC3339 format (default: now)"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleGetGroupGrowth(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	from, _ := request.GetArguments()["from"].(string)
+	to, _ := request.GetArguments()["to"].(string)
+
+	response, err := g.groupService.GetGroupGrowth(ctx, domainGroup.GroupMembershipPeriodRequest{
+		GroupID: groupID,
+		From:    from,
+		To:      to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Group %s from %s to %s: %d -> %d members (%d joined, %d left, net %+d)\n",
+		response.GroupID, response.From, response.To, response.MembersAtStart, response.MembersAtEnd,
+		response.Joined, response.Left, response.NetGrowth)
+	for _, point := range response.Daily {
+		if point.Joined == 0 && point.Left == 0 {
+			continue
+		}
+		result += fmt.Sprintf("- %s: +%d / -%d, %d members\n", point.Date, point.Joined, point.Left, point.Members)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (g *GroupHandler) toolGetGroupChurn() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_group_churn",
+		mcp.WithDescription("Report member churn over a period for one group, or for every group with stored membership history."),
+		mcp.WithString("group_id",
+			mcp.Description("Group ID (default: all tracked groups)"),
+		),
+		mcp.WithString("from",
+			mcp.Description("Start of the period in RFC3339 format (default: 30 days before to, at most 366 days before to)"),
+		),
+		mcp.WithString("to",
+			mcp.Description("End of the period in RFC3339 format (default: now)"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleGetGroupChurn(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID, _ := request.GetArguments()["group_id"].(string)
+	from, _ := request.GetArguments()["from"].(string)
+	to, _ := request.GetArguments()["to"].(string)
+
+	response, err := g.groupService.GetGroupChurn(ctx, domainGroup.GroupMembershipPeriodRequest{
+		GroupID: groupID,
+		From:    from,
+		To:      to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Data) == 0 {
+		return mcp.NewToolResultText("No membership history stored for any group"), nil
+	}
+
+	result := fmt.Sprintf("Churn from %s to %s:\n", response.From, response.To)
+	for _, churn := range response.Data {
+		result += fmt.Sprintf("- %s: %d members at start, %d joined, %d left, churn %.1f%%\n",
+			churn.GroupID, churn.MembersAtStart, churn.Joined, churn.Left, churn.ChurnRate*100)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (g *GroupHandler) toolBulkAddParticipants() mcp.Tool {
+	return mcp.NewTool("whatsapp_bulk_add_group_participants",
+		mcp.WithDescription("Add many participants to a group in paced batches. Participants whose privacy settings prevent adding them receive a group invite message instead."),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID"),
+		),
+		mcp.WithArray("participants",
+			mcp.Required(),
+			mcp.Description("Phone numbers with country code to add"),
+		),
+		mcp.WithString("invite_message",
+			mcp.Description("Caption of the invite sent to participants who cannot be added"),
+		),
+		mcp.WithNumber("batch_size",
+			mcp.Description("Participants added per batch (default: 20, max: 50)"),
+		),
+		mcp.WithNumber("batch_delay",
+			mcp.Description("Seconds to wait between batches (default: 5)"),
+		),
+	)
+}
+
+func (g *GroupHandler) handleBulkAddParticipants(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	groupID := request.GetArguments()["group_id"].(string)
+	participantsRaw := request.GetArguments()["participants"].([]interface{})
+	inviteMessage, _ := request.GetArguments()["invite_message"].(string)
+	batchSize, _ := request.GetArguments()["batch_size"].(float64)
+	batchDelay, _ := request.GetArguments()["batch_delay"].(float64)
+
+	participants := make([]string, len(participantsRaw))
+	for i, p := range participantsRaw {
+		participants[i] = p.(string)
+	}
+
+	response, err := g.groupService.BulkAddParticipants(ctx, domainGroup.BulkAddParticipantsRequest{
+		GroupID:       groupID,
+		Participants:  participants,
+		InviteMessage: inviteMessage,
+		BatchSize:     int(batchSize),
+		BatchDelay:    int(batchDelay),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Processed %d participants for group %s: %d added, %d invited, %d failed\n",
+		response.Total, response.GroupID, response.Added, response.Invited, response.Failed)
+	for _, participant := range response.Results {
+		result += fmt.Sprintf("- %s: %s", participant.Participant, participant.Status)
+		if participant.Reason != "" {
+			result += fmt.Sprintf(" (%s)", participant.Reason)
+		}
+		result += "\n"
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
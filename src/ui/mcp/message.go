@@ -3,6 +3,7 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	domainMessage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/message"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -30,12 +31,16 @@ func (m *MessageHandler) AddMessageTools(mcpServer *server.MCPServer) {
 	mcpServer.AddTool(m.toolRevoke(), m.handleRevoke)
 	mcpServer.AddTool(m.toolStar(), m.handleStar)
 	mcpServer.AddTool(m.toolUnstar(), m.handleUnstar)
+	mcpServer.AddTool(m.toolPin(), m.handlePin)
+	mcpServer.AddTool(m.toolUnpin(), m.handleUnpin)
 	mcpServer.AddTool(m.toolDownloadMedia(), m.handleDownloadMedia)
+	mcpServer.AddTool(m.toolGetPollResults(), m.handleGetPollResults)
+	mcpServer.AddTool(m.toolGetMessageReceipts(), m.handleGetMessageReceipts)
 }
 
 func (m *MessageHandler) toolReact() mcp.Tool {
 	return mcp.NewTool("whatsapp_react_message",
-		mcp.WithDescription("React to a WhatsApp message with an emoji."),
+		mcp.WithDescription("React to a WhatsApp message with an emoji, or remove your reaction with an empty emoji."),
 		mcp.WithString("phone",
 			mcp.Required(),
 			mcp.Description("Phone number or group ID"),
@@ -45,8 +50,7 @@ func (m *MessageHandler) toolReact() mcp.Tool {
 			mcp.Description("ID of the message to react to"),
 		),
 		mcp.WithString("emoji",
-			mcp.Required(),
-			mcp.Description("Emoji reaction (e.g., 👍, ❤️, 😂)"),
+			mcp.Description("Emoji reaction (e.g., 👍, ❤️, 😂), empty to remove the reaction"),
 		),
 	)
 }
@@ -54,7 +58,7 @@ func (m *MessageHandler) toolReact() mcp.Tool {
 func (m *MessageHandler) handleReact(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	phone := request.GetArguments()["phone"].(string)
 	messageID := request.GetArguments()["message_id"].(string)
-	emoji := request.GetArguments()["emoji"].(string)
+	emoji, _ := request.GetArguments()["emoji"].(string)
 
 	_, err := m.messageService.ReactMessage(ctx, domainMessage.ReactionRequest{
 		Phone:     phone,
@@ -66,6 +70,9 @@ func (m *MessageHandler) handleReact(ctx context.Context, request mcp.CallToolRe
 		return nil, err
 	}
 
+	if emoji == "" {
+		return mcp.NewToolResultText("Removed reaction from message"), nil
+	}
 	return mcp.NewToolResultText(fmt.Sprintf("Reacted with %s to message", emoji)), nil
 }
 
@@ -141,7 +148,7 @@ func (m *MessageHandler) handleMarkAsRead(ctx context.Context, request mcp.CallT
 
 func (m *MessageHandler) toolUpdate() mcp.Tool {
 	return mcp.NewTool("whatsapp_update_message",
-		mcp.WithDescription("Update/edit a WhatsApp message."),
+		mcp.WithDescription("Update/edit a WhatsApp message. For images, videos and documents the caption is replaced."),
 		mcp.WithString("phone",
 			mcp.Required(),
 			mcp.Description("Phone number or group ID"),
@@ -152,7 +159,7 @@ func (m *MessageHandler) toolUpdate() mcp.Tool {
 		),
 		mcp.WithString("message",
 			mcp.Required(),
-			mcp.Description("New message content"),
+			mcp.Description("New message content, or new caption for media messages"),
 		),
 	)
 }
@@ -267,6 +274,72 @@ func (m *MessageHandler) handleUnstar(ctx context.Context, request mcp.CallToolR
 	return mcp.NewToolResultText(fmt.Sprintf("Message %s unstarred successfully", messageID)), nil
 }
 
+func (m *MessageHandler) toolPin() mcp.Tool {
+	return mcp.NewTool("whatsapp_pin_message",
+		mcp.WithDescription("Pin a message inside a chat or group for everyone."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or group ID"),
+		),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the message to pin"),
+		),
+		mcp.WithString("duration",
+			mcp.Description("How long the message stays pinned: 24h, 7d or 30d (default: 7d)"),
+			mcp.Enum("24h", "7d", "30d"),
+		),
+	)
+}
+
+func (m *MessageHandler) handlePin(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+	messageID := request.GetArguments()["message_id"].(string)
+	duration, _ := request.GetArguments()["duration"].(string)
+
+	resp, err := m.messageService.PinMessage(ctx, domainMessage.PinMessageRequest{
+		Phone:     phone,
+		MessageID: messageID,
+		Duration:  duration,
+		IsPinned:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(resp.Status), nil
+}
+
+func (m *MessageHandler) toolUnpin() mcp.Tool {
+	return mcp.NewTool("whatsapp_unpin_message",
+		mcp.WithDescription("Unpin a message inside a chat or group."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or group ID"),
+		),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the message to unpin"),
+		),
+	)
+}
+
+func (m *MessageHandler) handleUnpin(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+	messageID := request.GetArguments()["message_id"].(string)
+
+	resp, err := m.messageService.PinMessage(ctx, domainMessage.PinMessageRequest{
+		Phone:     phone,
+		MessageID: messageID,
+		IsPinned:  false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(resp.Status), nil
+}
+
 func (m *MessageHandler) toolDownloadMedia() mcp.Tool {
 	return mcp.NewTool("whatsapp_download_media",
 		mcp.WithDescription("Download media from a WhatsApp message (image, video, audio, document)."),
@@ -298,4 +371,75 @@ func (m *MessageHandler) handleDownloadMedia(ctx context.Context, request mcp.Ca
 		response.MediaType, response.Filename, response.FilePath, response.FileSize)
 	
 	return mcp.NewToolResultText(result), nil
-}
\ No newline at end of file
+}
+
+func (m *MessageHandler) toolGetPollResults() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_poll_results",
+		mcp.WithDescription("Get the current results of a poll, with the latest vote of every voter."),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the poll message"),
+		),
+	)
+}
+
+func (m *MessageHandler) handleGetPollResults(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	messageID := request.GetArguments()["message_id"].(string)
+
+	response, err := m.messageService.GetPollResults(ctx, domainMessage.PollResultsRequest{
+		MessageID: messageID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Poll: %s\nVoters: %d\n", response.Question, response.TotalVoters)
+	for _, option := range response.Options {
+		result += fmt.Sprintf("- %s: %d vote(s)", option.Option, option.Votes)
+		if len(option.Voters) > 0 {
+			result += fmt.Sprintf(" (%s)", strings.Join(option.Voters, ", "))
+		}
+		result += "\n"
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (m *MessageHandler) toolGetMessageReceipts() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_message_receipts",
+		mcp.WithDescription("Get the delivery and read status of a sent message, with who received, read or played it in groups."),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of a message sent by this account"),
+		),
+	)
+}
+
+func (m *MessageHandler) handleGetMessageReceipts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	messageID := request.GetArguments()["message_id"].(string)
+
+	response, err := m.messageService.GetMessageReceipts(ctx, domainMessage.MessageReceiptsRequest{
+		MessageID: messageID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Message %s in %s: %s\n", response.MessageID, response.ChatJID, response.Status)
+	for _, receipt := range response.Receipts {
+		result += fmt.Sprintf("- %s: %s", receipt.Participant, receipt.Status)
+		switch receipt.Status {
+		case "played":
+			result += fmt.Sprintf(" at %s", receipt.PlayedAt)
+		case "read":
+			result += fmt.Sprintf(" at %s", receipt.ReadAt)
+		case "delivered":
+			result += fmt.Sprintf(" at %s", receipt.DeliveredAt)
+		}
+		result += "\n"
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
@@ -21,6 +21,14 @@ func InitMcpNewsletter(newsletterService domainNewsletter.INewsletterUsecase) *N
 
 func (n *NewsletterHandler) AddNewsletterTools(mcpServer *server.MCPServer) {
 	mcpServer.AddTool(n.toolUnfollow(), n.handleUnfollow)
+	mcpServer.AddTool(n.toolCreate(), n.handleCreate)
+	mcpServer.AddTool(n.toolUpdate(), n.handleUpdate)
+	mcpServer.AddTool(n.toolFollow(), n.handleFollow)
+	mcpServer.AddTool(n.toolMute(), n.handleMute)
+	mcpServer.AddTool(n.toolUnmute(), n.handleUnmute)
+	mcpServer.AddTool(n.toolGetInfo(), n.handleGetInfo)
+	mcpServer.AddTool(n.toolPost(), n.handlePost)
+	mcpServer.AddTool(n.toolGetMessages(), n.handleGetMessages)
 }
 
 func (n *NewsletterHandler) toolUnfollow() mcp.Tool {
@@ -45,4 +53,272 @@ func (n *NewsletterHandler) handleUnfollow(ctx context.Context, request mcp.Call
 	}
 
 	return mcp.NewToolResultText(fmt.Sprintf("Successfully unfollowed newsletter: %s", newsletterID)), nil
-}
\ No newline at end of file
+}
+func (n *NewsletterHandler) toolCreate() mcp.Tool {
+	return mcp.NewTool("whatsapp_create_newsletter",
+		mcp.WithDescription("Create a new WhatsApp newsletter (channel) owned by this account."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the newsletter"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Description of the newsletter"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleCreate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name := request.GetArguments()["name"].(string)
+	description, _ := request.GetArguments()["description"].(string)
+
+	resp, err := n.newsletterService.Create(ctx, domainNewsletter.CreateNewsletterRequest{
+		Name:        name,
+		Description: description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(n.formatNewsletter("Newsletter created", resp)), nil
+}
+
+func (n *NewsletterHandler) toolUpdate() mcp.Tool {
+	return mcp.NewTool("whatsapp_update_newsletter",
+		mcp.WithDescription("Update the name and/or description of a newsletter owned by this account."),
+		mcp.WithString("newsletter_id",
+			mcp.Required(),
+			mcp.Description("Newsletter ID to update"),
+		),
+		mcp.WithString("name",
+			mcp.Description("New name of the newsletter"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description of the newsletter"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleUpdate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	newsletterID := request.GetArguments()["newsletter_id"].(string)
+
+	updateRequest := domainNewsletter.UpdateNewsletterRequest{NewsletterID: newsletterID}
+	if name, ok := request.GetArguments()["name"].(string); ok {
+		updateRequest.Name = &name
+	}
+	if description, ok := request.GetArguments()["description"].(string); ok {
+		updateRequest.Description = &description
+	}
+
+	resp, err := n.newsletterService.Update(ctx, updateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(n.formatNewsletter("Newsletter updated", resp)), nil
+}
+
+func (n *NewsletterHandler) toolFollow() mcp.Tool {
+	return mcp.NewTool("whatsapp_follow_newsletter",
+		mcp.WithDescription("Follow a WhatsApp newsletter using its invite link or invite code."),
+		mcp.WithString("link",
+			mcp.Required(),
+			mcp.Description("Invite link (https://whatsapp.com/channel/...) or invite code"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleFollow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	link := request.GetArguments()["link"].(string)
+
+	resp, err := n.newsletterService.Follow(ctx, domainNewsletter.FollowNewsletterRequest{
+		Link: link,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(n.formatNewsletter("Followed newsletter", resp)), nil
+}
+
+func (n *NewsletterHandler) toolMute() mcp.Tool {
+	return mcp.NewTool("whatsapp_mute_newsletter",
+		mcp.WithDescription("Mute notifications of a followed newsletter."),
+		mcp.WithString("newsletter_id",
+			mcp.Required(),
+			mcp.Description("Newsletter ID to mute"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleMute(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	newsletterID := request.GetArguments()["newsletter_id"].(string)
+
+	err := n.newsletterService.Mute(ctx, domainNewsletter.MuteNewsletterRequest{
+		NewsletterID: newsletterID,
+		Mute:         true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully muted newsletter: %s", newsletterID)), nil
+}
+
+func (n *NewsletterHandler) toolUnmute() mcp.Tool {
+	return mcp.NewTool("whatsapp_unmute_newsletter",
+		mcp.WithDescription("Unmute notifications of a followed newsletter."),
+		mcp.WithString("newsletter_id",
+			mcp.Required(),
+			mcp.Description("Newsletter ID to unmute"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleUnmute(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	newsletterID := request.GetArguments()["newsletter_id"].(string)
+
+	err := n.newsletterService.Mute(ctx, domainNewsletter.MuteNewsletterRequest{
+		NewsletterID: newsletterID,
+		Mute:         false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully unmuted newsletter: %s", newsletterID)), nil
+}
+
+func (n *NewsletterHandler) toolGetInfo() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_newsletter_info",
+		mcp.WithDescription("Get newsletter details (name, description, followers) from an invite link or invite code."),
+		mcp.WithString("link",
+			mcp.Required(),
+			mcp.Description("Invite link (https://whatsapp.com/channel/...) or invite code"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleGetInfo(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	link := request.GetArguments()["link"].(string)
+
+	resp, err := n.newsletterService.GetInfo(ctx, domainNewsletter.GetNewsletterInfoRequest{
+		Link: link,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(n.formatNewsletter("Newsletter info", resp)), nil
+}
+
+func (n *NewsletterHandler) toolPost() mcp.Tool {
+	return mcp.NewTool("whatsapp_post_newsletter",
+		mcp.WithDescription("Post a text, image or video update to a newsletter owned by this account."),
+		mcp.WithString("newsletter_id",
+			mcp.Required(),
+			mcp.Description("Newsletter ID to post to"),
+		),
+		mcp.WithString("message",
+			mcp.Description("Text of the update, used as caption when media is attached"),
+		),
+		mcp.WithString("image_url",
+			mcp.Description("URL of an image to attach"),
+		),
+		mcp.WithString("video_url",
+			mcp.Description("URL of a video to attach"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handlePost(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	newsletterID := request.GetArguments()["newsletter_id"].(string)
+	message, _ := request.GetArguments()["message"].(string)
+
+	postRequest := domainNewsletter.PostNewsletterRequest{
+		NewsletterID: newsletterID,
+		Message:      message,
+	}
+	if imageURL, ok := request.GetArguments()["image_url"].(string); ok && imageURL != "" {
+		postRequest.ImageURL = &imageURL
+	}
+	if videoURL, ok := request.GetArguments()["video_url"].(string); ok && videoURL != "" {
+		postRequest.VideoURL = &videoURL
+	}
+
+	resp, err := n.newsletterService.Post(ctx, postRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Update posted to newsletter %s with ID %s", newsletterID, resp.MessageID)), nil
+}
+
+func (n *NewsletterHandler) toolGetMessages() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_newsletter_messages",
+		mcp.WithDescription("Read posts of a newsletter (channel) with their view and reaction counts, newest first."),
+		mcp.WithString("newsletter_id",
+			mcp.Required(),
+			mcp.Description("Newsletter ID to read"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Number of posts to retrieve (default: 50, max: 100)"),
+		),
+		mcp.WithNumber("before",
+			mcp.Description("Only return posts with a server ID lower than this, used for paging"),
+		),
+	)
+}
+
+func (n *NewsletterHandler) handleGetMessages(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	newsletterID := request.GetArguments()["newsletter_id"].(string)
+	limit := 0
+	if l, ok := request.GetArguments()["limit"].(float64); ok {
+		limit = int(l)
+	}
+	var before int64
+	if b, ok := request.GetArguments()["before"].(float64); ok {
+		before = int64(b)
+	}
+
+	resp, err := n.newsletterService.GetMessages(ctx, domainNewsletter.GetNewsletterMessagesRequest{
+		NewsletterID: newsletterID,
+		Limit:        limit,
+		Before:       before,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No posts found in newsletter %s", resp.NewsletterID)), nil
+	}
+
+	result := fmt.Sprintf("Posts of newsletter %s:\n\n", resp.NewsletterID)
+	for _, post := range resp.Data {
+		content := post.Content
+		if content == "" {
+			content = fmt.Sprintf("[%s]", post.MediaType)
+		}
+		reactions := 0
+		for _, count := range post.ReactionCounts {
+			reactions += count
+		}
+		result += fmt.Sprintf("#%d [%s] %s\n  views: %d, reactions: %d %v\n", post.ServerID, post.Timestamp, content, post.ViewsCount, reactions, post.ReactionCounts)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func (n *NewsletterHandler) formatNewsletter(title string, resp domainNewsletter.NewsletterResponse) string {
+	if resp.Newsletter == nil {
+		return title
+	}
+
+	meta := resp.Newsletter.ThreadMeta
+	result := fmt.Sprintf("%s:\nID: %s\nName: %s\nDescription: %s\nFollowers: %d",
+		title, resp.Newsletter.ID.String(), meta.Name.Text, meta.Description.Text, meta.SubscriberCount)
+	if resp.InviteLink != "" {
+		result += fmt.Sprintf("\nInvite link: %s", resp.InviteLink)
+	}
+	return result
+}
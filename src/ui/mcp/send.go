@@ -32,14 +32,31 @@ func (s *SendHandler) AddSendTools(mcpServer *server.MCPServer) {
 	
 	// Interactions
 	mcpServer.AddTool(s.toolSendContact(), s.handleSendContact)
+	mcpServer.AddTool(s.toolSendGroupInvite(), s.handleSendGroupInvite)
 	mcpServer.AddTool(s.toolSendLink(), s.handleSendLink)
 	mcpServer.AddTool(s.toolSendLocation(), s.handleSendLocation)
 	mcpServer.AddTool(s.toolSendPoll(), s.handleSendPoll)
+	mcpServer.AddTool(s.toolSendTemplate(), s.handleSendTemplate)
 	
 	// Presence
 	mcpServer.AddTool(s.toolSendPresence(), s.handleSendPresence)
 }
 
+// simulateTypingOption is the simulate_typing parameter shared by the send tools
+func simulateTypingOption() mcp.ToolOption {
+	return mcp.WithBoolean("simulate_typing",
+		mcp.Description("Mark the chat read and show typing (recording for voice notes) before sending, like a person would (default: account setting)"),
+	)
+}
+
+// simulateTypingArgument reads the simulate_typing parameter, nil when omitted so the account setting applies
+func simulateTypingArgument(request mcp.CallToolRequest) *bool {
+	if value, ok := request.GetArguments()["simulate_typing"].(bool); ok {
+		return &value
+	}
+	return nil
+}
+
 func (s *SendHandler) toolSendText() mcp.Tool {
 	sendTextTool := mcp.NewTool("whatsapp_send_text",
 		mcp.WithDescription("Send a text message to a WhatsApp contact or group."),
@@ -51,12 +68,19 @@ func (s *SendHandler) toolSendText() mcp.Tool {
 			mcp.Required(),
 			mcp.Description("The text message to send"),
 		),
+		mcp.WithString("format",
+			mcp.Description("Set to 'markdown' to convert CommonMark (**bold**, lists, links) to WhatsApp formatting (optional)"),
+		),
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 		mcp.WithString("reply_message_id",
 			mcp.Description("Message ID to reply to (optional)"),
 		),
+		mcp.WithBoolean("disable_link_preview",
+			mcp.Description("Skip the automatic rich preview for the first link in the message (default: false)"),
+		),
 	)
 
 	return sendTextTool
@@ -78,18 +102,33 @@ func (s *SendHandler) handleSendText(ctx context.Context, request mcp.CallToolRe
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
 	replyMessageId, ok := request.GetArguments()["reply_message_id"].(string)
 	if !ok {
 		replyMessageId = ""
 	}
 
+	disableLinkPreview, ok := request.GetArguments()["disable_link_preview"].(bool)
+	if !ok {
+		disableLinkPreview = false
+	}
+
+	format, ok := request.GetArguments()["format"].(string)
+	if !ok {
+		format = ""
+	}
+
 	res, err := s.sendService.SendText(ctx, domainSend.MessageRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
-		Message:        message,
-		ReplyMessageID: &replyMessageId,
+		Message:            message,
+		ReplyMessageID:     &replyMessageId,
+		DisableLinkPreview: disableLinkPreview,
+		Format:             format,
 	})
 
 	if err != nil {
@@ -117,6 +156,7 @@ func (s *SendHandler) toolSendContact() mcp.Tool {
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 
 	return sendContactTool
@@ -143,10 +183,13 @@ func (s *SendHandler) handleSendContact(ctx context.Context, request mcp.CallToo
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
 	res, err := s.sendService.SendContact(ctx, domainSend.ContactRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		ContactName:  contactName,
 		ContactPhone: contactPhone,
@@ -159,6 +202,62 @@ func (s *SendHandler) handleSendContact(ctx context.Context, request mcp.CallToo
 	return mcp.NewToolResultText(fmt.Sprintf("Contact sent successfully with ID %s", res.MessageID)), nil
 }
 
+func (s *SendHandler) toolSendGroupInvite() mcp.Tool {
+	sendGroupInviteTool := mcp.NewTool("whatsapp_send_group_invite",
+		mcp.WithDescription("Share the invite link of a group you administer as a rich invite card. Without an expiration the card stays valid until the invite link is revoked."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number to send the invite to"),
+		),
+		mcp.WithString("group_id",
+			mcp.Required(),
+			mcp.Description("Group ID to invite to"),
+		),
+		mcp.WithString("caption",
+			mcp.Description("Message shown with the invite (optional)"),
+		),
+		mcp.WithNumber("expiration",
+			mcp.Description("Seconds until the invite card expires (optional, max: 30 days); the invite link itself stays valid"),
+		),
+		simulateTypingOption(),
+	)
+
+	return sendGroupInviteTool
+}
+
+func (s *SendHandler) handleSendGroupInvite(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone, ok := request.GetArguments()["phone"].(string)
+	if !ok {
+		return nil, errors.New("phone must be a string")
+	}
+
+	groupID, ok := request.GetArguments()["group_id"].(string)
+	if !ok {
+		return nil, errors.New("group_id must be a string")
+	}
+
+	caption, _ := request.GetArguments()["caption"].(string)
+	expiration, _ := request.GetArguments()["expiration"].(float64)
+
+	simulateTyping := simulateTypingArgument(request)
+
+	res, err := s.sendService.SendGroupInvite(ctx, domainSend.GroupInviteRequest{
+		BaseRequest: domainSend.BaseRequest{
+			Phone:          phone,
+			SimulateTyping: simulateTyping,
+		},
+		GroupID:    groupID,
+		Caption:    caption,
+		Expiration: int(expiration),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Group invite sent successfully with ID %s", res.MessageID)), nil
+}
+
 func (s *SendHandler) toolSendLink() mcp.Tool {
 	sendLinkTool := mcp.NewTool("whatsapp_send_link",
 		mcp.WithDescription("Send a link with caption to a WhatsApp contact or group."),
@@ -174,9 +273,13 @@ func (s *SendHandler) toolSendLink() mcp.Tool {
 			mcp.Required(),
 			mcp.Description("Caption or description for the link"),
 		),
+		mcp.WithString("format",
+			mcp.Description("Set to 'markdown' to convert CommonMark (**bold**, lists, links) to WhatsApp formatting (optional)"),
+		),
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 
 	return sendLinkTool
@@ -203,13 +306,22 @@ func (s *SendHandler) handleSendLink(ctx context.Context, request mcp.CallToolRe
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
+	format, ok := request.GetArguments()["format"].(string)
+	if !ok {
+		format = ""
+	}
+
 	res, err := s.sendService.SendLink(ctx, domainSend.LinkRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		Link:    link,
 		Caption: caption,
+		Format:  format,
 	})
 
 	if err != nil {
@@ -237,6 +349,7 @@ func (s *SendHandler) toolSendLocation() mcp.Tool {
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 
 	return sendLocationTool
@@ -263,10 +376,13 @@ func (s *SendHandler) handleSendLocation(ctx context.Context, request mcp.CallTo
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
 	res, err := s.sendService.SendLocation(ctx, domainSend.LocationRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		Latitude:  latitude,
 		Longitude: longitude,
@@ -292,6 +408,9 @@ func (s *SendHandler) toolSendImage() mcp.Tool {
 		mcp.WithString("caption",
 			mcp.Description("Caption or description for the image"),
 		),
+		mcp.WithString("format",
+			mcp.Description("Set to 'markdown' to convert CommonMark (**bold**, lists, links) to WhatsApp formatting (optional)"),
+		),
 		mcp.WithBoolean("view_once",
 			mcp.Description("Whether this image should be viewed only once (default: false)"),
 		),
@@ -301,6 +420,7 @@ func (s *SendHandler) toolSendImage() mcp.Tool {
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 
 	return sendImageTool
@@ -337,15 +457,24 @@ func (s *SendHandler) handleSendImage(ctx context.Context, request mcp.CallToolR
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
+	format, ok := request.GetArguments()["format"].(string)
+	if !ok {
+		format = ""
+	}
+
 	// Create image request
 	imageRequest := domainSend.ImageRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		Caption:  caption,
 		ViewOnce: viewOnce,
 		Compress: compress,
+		Format:   format,
 	}
 
 	if imageURLOk && imageURL != "" {
@@ -375,6 +504,7 @@ func (s *SendHandler) toolSendAudio() mcp.Tool {
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 }
 
@@ -394,10 +524,13 @@ func (s *SendHandler) handleSendAudio(ctx context.Context, request mcp.CallToolR
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
 	res, err := s.sendService.SendAudio(ctx, domainSend.AudioRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		AudioURL: &audioURL,
 	})
@@ -423,6 +556,9 @@ func (s *SendHandler) toolSendVideo() mcp.Tool {
 		mcp.WithString("caption",
 			mcp.Description("Caption or description for the video"),
 		),
+		mcp.WithString("format",
+			mcp.Description("Set to 'markdown' to convert CommonMark (**bold**, lists, links) to WhatsApp formatting (optional)"),
+		),
 		mcp.WithBoolean("view_once",
 			mcp.Description("Whether this video should be viewed only once (default: false)"),
 		),
@@ -432,6 +568,7 @@ func (s *SendHandler) toolSendVideo() mcp.Tool {
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 }
 
@@ -466,15 +603,24 @@ func (s *SendHandler) handleSendVideo(ctx context.Context, request mcp.CallToolR
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
+	format, ok := request.GetArguments()["format"].(string)
+	if !ok {
+		format = ""
+	}
+
 	res, err := s.sendService.SendVideo(ctx, domainSend.VideoRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		Caption:  caption,
 		ViewOnce: viewOnce,
 		Compress: compress,
 		VideoURL: &videoURL,
+		Format:   format,
 	})
 
 	if err != nil {
@@ -498,17 +644,60 @@ func (s *SendHandler) toolSendFile() mcp.Tool {
 		mcp.WithString("caption",
 			mcp.Description("Caption or description for the file"),
 		),
+		mcp.WithString("format",
+			mcp.Description("Set to 'markdown' to convert CommonMark (**bold**, lists, links) to WhatsApp formatting (optional)"),
+		),
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 }
 
 func (s *SendHandler) handleSendFile(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Note: File sending via URL is not directly supported by the FileRequest struct
-	// which expects multipart.FileHeader. For MCP integration with AI agents,
-	// we'll return a helpful message about this limitation.
-	return mcp.NewToolResultText("File sending via URL requires file upload capability not available in MCP context. Use image, audio, or video tools for media files with URLs."), nil
+	phone, ok := request.GetArguments()["phone"].(string)
+	if !ok {
+		return nil, errors.New("phone must be a string")
+	}
+
+	fileURL, ok := request.GetArguments()["file_url"].(string)
+	if !ok {
+		return nil, errors.New("file_url must be a string")
+	}
+
+	caption, ok := request.GetArguments()["caption"].(string)
+	if !ok {
+		caption = ""
+	}
+
+	isForwarded, ok := request.GetArguments()["is_forwarded"].(bool)
+	if !ok {
+		isForwarded = false
+	}
+
+	simulateTyping := simulateTypingArgument(request)
+
+	format, ok := request.GetArguments()["format"].(string)
+	if !ok {
+		format = ""
+	}
+
+	res, err := s.sendService.SendFile(ctx, domainSend.FileRequest{
+		BaseRequest: domainSend.BaseRequest{
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
+		},
+		Caption: caption,
+		FileURL: &fileURL,
+		Format:  format,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("File sent successfully with ID %s", res.MessageID)), nil
 }
 
 func (s *SendHandler) toolSendPoll() mcp.Tool {
@@ -532,6 +721,7 @@ func (s *SendHandler) toolSendPoll() mcp.Tool {
 		mcp.WithBoolean("is_forwarded",
 			mcp.Description("Whether this message is being forwarded (default: false)"),
 		),
+		simulateTypingOption(),
 	)
 }
 
@@ -570,10 +760,13 @@ func (s *SendHandler) handleSendPoll(ctx context.Context, request mcp.CallToolRe
 		isForwarded = false
 	}
 
+	simulateTyping := simulateTypingArgument(request)
+
 	res, err := s.sendService.SendPoll(ctx, domainSend.PollRequest{
 		BaseRequest: domainSend.BaseRequest{
-			Phone:       phone,
-			IsForwarded: isForwarded,
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
 		},
 		Question:  question,
 		Options:   options,
@@ -587,6 +780,76 @@ func (s *SendHandler) handleSendPoll(ctx context.Context, request mcp.CallToolRe
 	return mcp.NewToolResultText(fmt.Sprintf("Poll sent successfully with ID %s", res.MessageID)), nil
 }
 
+func (s *SendHandler) toolSendTemplate() mcp.Tool {
+	return mcp.NewTool("whatsapp_send_template",
+		mcp.WithDescription("Send a stored message template to a WhatsApp contact or group, filling its variables."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or group ID to send the template to"),
+		),
+		mcp.WithString("template_name",
+			mcp.Required(),
+			mcp.Description("Name of the stored template"),
+		),
+		mcp.WithNumber("version",
+			mcp.Description("Template version to send (default: latest)"),
+		),
+		mcp.WithObject("variables",
+			mcp.Description("Values for the template variables, e.g. {\"name\": \"Budi\"}"),
+		),
+		mcp.WithBoolean("is_forwarded",
+			mcp.Description("Whether this message is being forwarded (default: false)"),
+		),
+		simulateTypingOption(),
+	)
+}
+
+func (s *SendHandler) handleSendTemplate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone, ok := request.GetArguments()["phone"].(string)
+	if !ok {
+		return nil, errors.New("phone must be a string")
+	}
+
+	templateName, ok := request.GetArguments()["template_name"].(string)
+	if !ok {
+		return nil, errors.New("template_name must be a string")
+	}
+
+	version, ok := request.GetArguments()["version"].(float64)
+	if !ok {
+		version = 0
+	}
+
+	variables, ok := request.GetArguments()["variables"].(map[string]any)
+	if !ok {
+		variables = map[string]any{}
+	}
+
+	isForwarded, ok := request.GetArguments()["is_forwarded"].(bool)
+	if !ok {
+		isForwarded = false
+	}
+
+	simulateTyping := simulateTypingArgument(request)
+
+	res, err := s.sendService.SendTemplate(ctx, domainSend.TemplateRequest{
+		BaseRequest: domainSend.BaseRequest{
+			Phone:          phone,
+			IsForwarded:    isForwarded,
+			SimulateTyping: simulateTyping,
+		},
+		TemplateName: templateName,
+		Version:      int(version),
+		Variables:    variables,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Template %s sent successfully with ID %s", templateName, res.MessageID)), nil
+}
+
 func (s *SendHandler) toolSendPresence() mcp.Tool {
 	return mcp.NewTool("whatsapp_send_presence",
 		mcp.WithDescription("Send typing indicator or online presence to WhatsApp."),
@@ -0,0 +1,153 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	domainTemplate "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/template"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type TemplateHandler struct {
+	templateService domainTemplate.ITemplateUsecase
+}
+
+func InitMcpTemplate(templateService domainTemplate.ITemplateUsecase) *TemplateHandler {
+	return &TemplateHandler{
+		templateService: templateService,
+	}
+}
+
+func (t *TemplateHandler) AddTemplateTools(mcpServer *server.MCPServer) {
+	mcpServer.AddTool(t.toolSaveTemplate(), t.handleSaveTemplate)
+	mcpServer.AddTool(t.toolListTemplates(), t.handleListTemplates)
+	mcpServer.AddTool(t.toolPreviewTemplate(), t.handlePreviewTemplate)
+}
+
+func (t *TemplateHandler) toolSaveTemplate() mcp.Tool {
+	return mcp.NewTool("whatsapp_save_template",
+		mcp.WithDescription("Create a message template, or a new version of an existing one. The body uses Go template syntax, e.g. 'Hi {{.name}}'."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Template name (lowercase letters, digits, '_', '.' or '-')"),
+		),
+		mcp.WithString("body",
+			mcp.Required(),
+			mcp.Description("Template body; used as the caption when media is attached. Variables inside if, with or range blocks or passed through default are optional"),
+		),
+		mcp.WithString("media_type",
+			mcp.Description("Optional media to send with the template: image, video, document or audio (the body follows audio as a text message)"),
+		),
+		mcp.WithString("media_url",
+			mcp.Description("Media URL, required when media_type is set; may contain variables"),
+		),
+	)
+}
+
+func (t *TemplateHandler) handleSaveTemplate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := request.GetArguments()["name"].(string)
+	if !ok {
+		return nil, errors.New("name must be a string")
+	}
+
+	body, ok := request.GetArguments()["body"].(string)
+	if !ok {
+		return nil, errors.New("body must be a string")
+	}
+
+	mediaType, _ := request.GetArguments()["media_type"].(string)
+	mediaURL, _ := request.GetArguments()["media_url"].(string)
+
+	res, err := t.templateService.SaveTemplate(ctx, domainTemplate.SaveTemplateRequest{
+		Name:      name,
+		Body:      body,
+		MediaType: mediaType,
+		MediaURL:  mediaURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Template %s saved as version %d (variables: %s)", res.Name, res.Version, strings.Join(res.Variables, ", "))), nil
+}
+
+func (t *TemplateHandler) toolListTemplates() mcp.Tool {
+	return mcp.NewTool("whatsapp_list_templates",
+		mcp.WithDescription("List the latest version of every stored message template."),
+	)
+}
+
+func (t *TemplateHandler) handleListTemplates(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	templates, err := t.templateService.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(templates) == 0 {
+		return mcp.NewToolResultText("No templates found"), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Found %d templates:\n\n", len(templates)))
+	for _, template := range templates {
+		result.WriteString(fmt.Sprintf("- %s (v%d)", template.Name, template.Version))
+		if template.MediaType != "" {
+			result.WriteString(fmt.Sprintf(" [%s]", template.MediaType))
+		}
+		result.WriteString(fmt.Sprintf("\n  Variables: %s\n  Body: %s\n", strings.Join(template.Variables, ", "), template.Body))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func (t *TemplateHandler) toolPreviewTemplate() mcp.Tool {
+	return mcp.NewTool("whatsapp_preview_template",
+		mcp.WithDescription("Render a stored message template with variables without sending it."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Template name"),
+		),
+		mcp.WithNumber("version",
+			mcp.Description("Template version to render (default: latest)"),
+		),
+		mcp.WithObject("variables",
+			mcp.Description("Values for the template variables"),
+		),
+	)
+}
+
+func (t *TemplateHandler) handlePreviewTemplate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := request.GetArguments()["name"].(string)
+	if !ok {
+		return nil, errors.New("name must be a string")
+	}
+
+	version, ok := request.GetArguments()["version"].(float64)
+	if !ok {
+		version = 0
+	}
+
+	variables, ok := request.GetArguments()["variables"].(map[string]any)
+	if !ok {
+		variables = map[string]any{}
+	}
+
+	res, err := t.templateService.PreviewTemplate(ctx, domainTemplate.PreviewTemplateRequest{
+		Name:      name,
+		Version:   int(version),
+		Variables: variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := res.Body
+	if res.MediaType != "" {
+		preview = fmt.Sprintf("[%s: %s]\n%s", res.MediaType, res.MediaURL, res.Body)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Preview of %s v%d:\n\n%s", res.Name, res.Version, preview)), nil
+}
@@ -23,12 +23,14 @@ func (u *UserHandler) AddUserTools(mcpServer *server.MCPServer) {
 	// User information
 	mcpServer.AddTool(u.toolGetInfo(), u.handleGetInfo)
 	mcpServer.AddTool(u.toolCheckPhone(), u.handleCheckPhone)
+	mcpServer.AddTool(u.toolBulkCheckPhones(), u.handleBulkCheckPhones)
 	mcpServer.AddTool(u.toolBusinessProfile(), u.handleBusinessProfile)
 	
 	// Profile management
 	mcpServer.AddTool(u.toolGetAvatar(), u.handleGetAvatar)
 	mcpServer.AddTool(u.toolChangeAvatar(), u.handleChangeAvatar)
 	mcpServer.AddTool(u.toolChangePushName(), u.handleChangePushName)
+	mcpServer.AddTool(u.toolChangeAbout(), u.handleChangeAbout)
 	
 	// Listings
 	mcpServer.AddTool(u.toolMyListGroups(), u.handleMyListGroups)
@@ -37,6 +39,15 @@ func (u *UserHandler) AddUserTools(mcpServer *server.MCPServer) {
 	
 	// Privacy
 	mcpServer.AddTool(u.toolGetMyPrivacy(), u.handleGetMyPrivacy)
+	mcpServer.AddTool(u.toolUpdateMyPrivacy(), u.handleUpdateMyPrivacy)
+
+	// Blocklist
+	mcpServer.AddTool(u.toolBlockContact(), u.handleBlockContact)
+	mcpServer.AddTool(u.toolUnblockContact(), u.handleUnblockContact)
+	mcpServer.AddTool(u.toolGetBlocklist(), u.handleGetBlocklist)
+
+	// Presence
+	mcpServer.AddTool(u.toolGetPresence(), u.handleGetPresence)
 }
 
 func (u *UserHandler) toolGetInfo() mcp.Tool {
@@ -149,6 +160,48 @@ func (u *UserHandler) handleCheckPhone(ctx context.Context, request mcp.CallTool
 	return mcp.NewToolResultText(result), nil
 }
 
+func (u *UserHandler) toolBulkCheckPhones() mcp.Tool {
+	return mcp.NewTool("whatsapp_bulk_check_phones",
+		mcp.WithDescription("Check which of many phone numbers are registered on WhatsApp. Numbers are checked in batches and recent results are served from cache."),
+		mcp.WithArray("phones",
+			mcp.Required(),
+			mcp.Description("Phone numbers with country code to check"),
+		),
+	)
+}
+
+func (u *UserHandler) handleBulkCheckPhones(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phonesRaw := request.GetArguments()["phones"].([]interface{})
+
+	phones := make([]string, len(phonesRaw))
+	for i, p := range phonesRaw {
+		phones[i] = p.(string)
+	}
+
+	response, err := u.userService.BulkIsOnWhatsApp(ctx, domainUser.BulkCheckRequest{
+		Phones: phones,
+	})
+	
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Checked %d numbers: %d on WhatsApp, %d not on WhatsApp, %d invalid\n", response.Total, response.OnWhatsApp, response.NotOnWhatsApp, response.Invalid)
+	for _, item := range response.Results {
+		switch {
+		case item.Error != "":
+			result += fmt.Sprintf("- %s: %s\n", item.Phone, item.Error)
+		case item.IsOnWhatsApp && item.VerifiedName != "":
+			result += fmt.Sprintf("- %s: on WhatsApp (%s, business: %s)\n", item.Phone, item.JID, item.VerifiedName)
+		case item.IsOnWhatsApp:
+			result += fmt.Sprintf("- %s: on WhatsApp (%s)\n", item.Phone, item.JID)
+		default:
+			result += fmt.Sprintf("- %s: not on WhatsApp\n", item.Phone)
+		}
+	}
+	return mcp.NewToolResultText(result), nil
+}
+
 func (u *UserHandler) toolGetMyPrivacy() mcp.Tool {
 	return mcp.NewTool("whatsapp_get_my_privacy",
 		mcp.WithDescription("Get privacy settings of the logged-in WhatsApp account."),
@@ -162,8 +215,72 @@ func (u *UserHandler) handleGetMyPrivacy(ctx context.Context, request mcp.CallTo
 		return nil, err
 	}
 
-	result := fmt.Sprintf("Privacy Settings:\nLast Seen: %s\nProfile: %s\nStatus: %s\nRead Receipts: %s\nGroups: %s", 
-		response.LastSeen, response.Profile, response.Status, response.ReadReceipts, response.GroupAdd)
+	result := fmt.Sprintf("Privacy Settings:\nLast Seen: %s\nProfile: %s\nAbout: %s\nRead Receipts: %s\nGroups: %s", 
+		response.LastSeen, response.Profile, response.About, response.ReadReceipts, response.GroupAdd)
+	return mcp.NewToolResultText(result), nil
+}
+
+func (u *UserHandler) toolUpdateMyPrivacy() mcp.Tool {
+	audience := []string{"all", "contacts", "contact_blacklist", "none"}
+
+	return mcp.NewTool("whatsapp_update_my_privacy",
+		mcp.WithDescription("Update privacy settings of the logged-in WhatsApp account. Omitted settings are left unchanged, so a full provisioning profile can be applied in one call."),
+		mcp.WithString("last_seen",
+			mcp.Description("Who can see the last seen time"),
+			mcp.Enum(audience...),
+		),
+		mcp.WithString("online",
+			mcp.Description("Who can see when the account is online"),
+			mcp.Enum("all", "match_last_seen"),
+		),
+		mcp.WithString("profile",
+			mcp.Description("Who can see the profile photo"),
+			mcp.Enum(audience...),
+		),
+		mcp.WithString("about",
+			mcp.Description("Who can see the about text"),
+			mcp.Enum(audience...),
+		),
+		mcp.WithString("read_receipts",
+			mcp.Description("Whether read receipts are sent"),
+			mcp.Enum("all", "none"),
+		),
+		mcp.WithString("group_add",
+			mcp.Description("Who can add the account to groups"),
+			mcp.Enum(audience...),
+		),
+		mcp.WithString("call_add",
+			mcp.Description("Who can call the account"),
+			mcp.Enum("all", "known"),
+		),
+	)
+}
+
+func (u *UserHandler) handleUpdateMyPrivacy(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetArguments()
+	lastSeen, _ := args["last_seen"].(string)
+	online, _ := args["online"].(string)
+	profile, _ := args["profile"].(string)
+	about, _ := args["about"].(string)
+	readReceipts, _ := args["read_receipts"].(string)
+	groupAdd, _ := args["group_add"].(string)
+	callAdd, _ := args["call_add"].(string)
+
+	response, err := u.userService.UpdatePrivacySetting(ctx, domainUser.UpdatePrivacySettingRequest{
+		LastSeen:     lastSeen,
+		Online:       online,
+		Profile:      profile,
+		About:        about,
+		ReadReceipts: readReceipts,
+		GroupAdd:     groupAdd,
+		CallAdd:      callAdd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Privacy Settings updated:\nLast Seen: %s\nOnline: %s\nProfile: %s\nAbout: %s\nRead Receipts: %s\nGroups: %s\nCalls: %s",
+		response.LastSeen, response.Online, response.Profile, response.About, response.ReadReceipts, response.GroupAdd, response.CallAdd)
 	return mcp.NewToolResultText(result), nil
 }
 
@@ -254,6 +371,29 @@ func (u *UserHandler) handleChangePushName(ctx context.Context, request mcp.Call
 	return mcp.NewToolResultText(fmt.Sprintf("Display name changed to: %s", pushName)), nil
 }
 
+func (u *UserHandler) toolChangeAbout() mcp.Tool {
+	return mcp.NewTool("whatsapp_change_about",
+		mcp.WithDescription("Change the about text shown on the profile of the logged-in WhatsApp account."),
+		mcp.WithString("about",
+			mcp.Required(),
+			mcp.Description("New about text, up to 139 characters"),
+		),
+	)
+}
+
+func (u *UserHandler) handleChangeAbout(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	about := request.GetArguments()["about"].(string)
+
+	err := u.userService.ChangeAbout(ctx, domainUser.ChangeAboutRequest{
+		About: about,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("About changed to: %s", about)), nil
+}
+
 // ===== LISTING TOOLS =====
 
 func (u *UserHandler) toolMyListNewsletter() mcp.Tool {
@@ -276,7 +416,7 @@ func (u *UserHandler) handleMyListNewsletter(ctx context.Context, request mcp.Ca
 	result := fmt.Sprintf("My Newsletters (%d):\n", len(response.Data))
 	for _, newsletter := range response.Data {
 		result += fmt.Sprintf("- ID: %s\n", newsletter.ID.String())
-		result += fmt.Sprintf("  State: %d\n", newsletter.State)
+		result += fmt.Sprintf("  State: %s\n", newsletter.State.Type)
 		result += fmt.Sprintf("  Created: %s\n", newsletter.ThreadMeta.CreationTime.Format("2006-01-02 15:04:05"))
 	}
 	return mcp.NewToolResultText(result), nil
@@ -304,4 +444,116 @@ func (u *UserHandler) handleMyListContacts(ctx context.Context, request mcp.Call
 		result += fmt.Sprintf("- %s (JID: %s)\n", contact.Name, contact.JID.String())
 	}
 	return mcp.NewToolResultText(result), nil
-}
\ No newline at end of file
+}
+
+// ===== BLOCKLIST TOOLS =====
+
+func (u *UserHandler) toolBlockContact() mcp.Tool {
+	return mcp.NewTool("whatsapp_block_contact",
+		mcp.WithDescription("Block a contact so they can no longer message or call the logged-in account."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or JID of the contact to block"),
+		),
+	)
+}
+
+func (u *UserHandler) handleBlockContact(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+
+	response, err := u.userService.BlockContact(ctx, domainUser.BlockContactRequest{
+		Phone: phone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Blocked %s, %d contact(s) are now blocked", phone, len(response.Data))), nil
+}
+
+func (u *UserHandler) toolUnblockContact() mcp.Tool {
+	return mcp.NewTool("whatsapp_unblock_contact",
+		mcp.WithDescription("Unblock a previously blocked contact."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number or JID of the contact to unblock"),
+		),
+	)
+}
+
+func (u *UserHandler) handleUnblockContact(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+
+	response, err := u.userService.UnblockContact(ctx, domainUser.BlockContactRequest{
+		Phone: phone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Unblocked %s, %d contact(s) remain blocked", phone, len(response.Data))), nil
+}
+
+func (u *UserHandler) toolGetBlocklist() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_blocklist",
+		mcp.WithDescription("Get the list of contacts blocked by the logged-in account."),
+	)
+}
+
+func (u *UserHandler) handleGetBlocklist(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	response, err := u.userService.GetBlocklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Data) == 0 {
+		return mcp.NewToolResultText("No blocked contacts"), nil
+	}
+
+	result := fmt.Sprintf("Blocked Contacts (%d):\n", len(response.Data))
+	for _, jid := range response.Data {
+		result += fmt.Sprintf("- %s\n", jid)
+	}
+	return mcp.NewToolResultText(result), nil
+}
+
+func (u *UserHandler) toolGetPresence() mcp.Tool {
+	return mcp.NewTool("whatsapp_get_presence",
+		mcp.WithDescription("Get whether a contact is online, when they were last seen and whether they are typing. Presence is only reported for subscribed contacts, so subscribe first and ask again later."),
+		mcp.WithString("phone",
+			mcp.Required(),
+			mcp.Description("Phone number of the contact"),
+		),
+		mcp.WithBoolean("subscribe",
+			mcp.Description("Subscribe to presence updates of the contact before reading it (default: false)"),
+		),
+	)
+}
+
+func (u *UserHandler) handleGetPresence(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	phone := request.GetArguments()["phone"].(string)
+	subscribe, _ := request.GetArguments()["subscribe"].(bool)
+
+	var response domainUser.PresenceResponse
+	var err error
+	if subscribe {
+		response, err = u.userService.SubscribePresence(ctx, domainUser.SubscribePresenceRequest{Phone: phone})
+	} else {
+		response, err = u.userService.GetPresence(ctx, domainUser.PresenceRequest{Phone: phone})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("Presence of %s:\nSubscribed: %v\nOnline: %v", response.JID, response.Subscribed, response.IsOnline)
+	if response.LastSeen != "" {
+		result += fmt.Sprintf("\nLast Seen: %s", response.LastSeen)
+	}
+	if response.ChatState != "" {
+		result += fmt.Sprintf("\nChat State: %s in %s at %s", response.ChatState, response.ChatStateChat, response.ChatStateAt)
+	}
+	if response.UpdatedAt == "" {
+		result += "\nNo presence update received yet"
+	}
+	return mcp.NewToolResultText(result), nil
+}
@@ -16,7 +16,11 @@ func InitRestChat(app fiber.Router, service domainChat.IChatUsecase) Chat {
 	// Chat endpoints
 	app.Get("/chats", rest.ListChats)
 	app.Get("/chat/:chat_jid/messages", rest.GetChatMessages)
+	app.Get("/chat/:chat_jid/pinned-messages", rest.GetPinnedMessages)
 	app.Post("/chat/:chat_jid/pin", rest.PinChat)
+	app.Post("/chat/:chat_jid/disappearing-timer", rest.SetDisappearingTimer)
+	app.Post("/chats/disappearing-timer", rest.SetDefaultDisappearingTimer)
+	app.Get("/calls", rest.ListCalls)
 
 	return rest
 }
@@ -104,3 +108,75 @@ func (controller *Chat) PinChat(c *fiber.Ctx) error {
 		Results: response,
 	})
 }
+
+func (controller *Chat) SetDisappearingTimer(c *fiber.Ctx) error {
+	var request domainChat.SetDisappearingTimerRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.ChatJID = c.Params("chat_jid")
+
+	response, err := controller.Service.SetDisappearingTimer(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: response.Message,
+		Results: response,
+	})
+}
+
+func (controller *Chat) SetDefaultDisappearingTimer(c *fiber.Ctx) error {
+	var request domainChat.SetDefaultDisappearingTimerRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	response, err := controller.Service.SetDefaultDisappearingTimer(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: response.Message,
+		Results: response,
+	})
+}
+
+func (controller *Chat) GetPinnedMessages(c *fiber.Ctx) error {
+	var request domainChat.GetPinnedMessagesRequest
+	request.ChatJID = c.Params("chat_jid")
+
+	response, err := controller.Service.GetPinnedMessages(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get pinned messages",
+		Results: response,
+	})
+}
+
+func (controller *Chat) ListCalls(c *fiber.Ctx) error {
+	var request domainChat.ListCallsRequest
+
+	// Parse query parameters
+	request.Phone = c.Query("phone", "")
+	request.Limit = c.QueryInt("limit", 25)
+	request.Offset = c.QueryInt("offset", 0)
+
+	if request.Phone != "" {
+		utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+	}
+
+	response, err := controller.Service.ListCalls(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get call log",
+		Results: response,
+	})
+}
@@ -19,10 +19,12 @@ func InitRestGroup(app fiber.Router, service domainGroup.IGroupUsecase) Group {
 	rest := Group{Service: service}
 	app.Post("/group", rest.CreateGroup)
 	app.Post("/group/join-with-link", rest.JoinGroupWithLink)
+	app.Post("/group/invite/accept", rest.AcceptGroupInvite)
 	app.Get("/group/info-from-link", rest.GetGroupInfoFromLink)
 	app.Get("/group/info", rest.GroupInfo)
 	app.Post("/group/leave", rest.LeaveGroup)
 	app.Post("/group/participants", rest.AddParticipants)
+	app.Post("/group/participants/bulk", rest.BulkAddParticipants)
 	app.Post("/group/participants/remove", rest.DeleteParticipants)
 	app.Post("/group/participants/promote", rest.PromoteParticipants)
 	app.Post("/group/participants/demote", rest.DemoteParticipants)
@@ -34,7 +36,19 @@ func InitRestGroup(app fiber.Router, service domainGroup.IGroupUsecase) Group {
 	app.Post("/group/locked", rest.SetGroupLocked)
 	app.Post("/group/announce", rest.SetGroupAnnounce)
 	app.Post("/group/topic", rest.SetGroupTopic)
+	app.Post("/group/join-approval", rest.SetGroupJoinApproval)
+	app.Post("/group/member-add-mode", rest.SetGroupMemberAddMode)
+	app.Post("/group/ephemeral", rest.SetGroupEphemeral)
 	app.Get("/group/invite-link", rest.GetGroupInviteLink)
+	app.Post("/group/invite-link/revoke", rest.RevokeGroupInviteLink)
+	app.Post("/group/community/link", rest.LinkCommunityGroup)
+	app.Post("/group/community/unlink", rest.UnlinkCommunityGroup)
+	app.Get("/group/community/subgroups", rest.GetCommunitySubGroups)
+	app.Get("/group/community/participants", rest.GetCommunityParticipants)
+	app.Post("/group/community/announce", rest.SendCommunityAnnouncement)
+	app.Get("/group/membership", rest.GetGroupMembership)
+	app.Get("/group/membership/growth", rest.GetGroupGrowth)
+	app.Get("/group/membership/churn", rest.GetGroupChurn)
 	return rest
 }
 
@@ -56,6 +70,27 @@ func (controller *Group) JoinGroupWithLink(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *Group) AcceptGroupInvite(c *fiber.Ctx) error {
+	var request domainGroup.AcceptGroupInviteRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Inviter))
+
+	response, err := controller.Service.AcceptGroupInvite(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success accepted group invite",
+		Results: map[string]string{
+			"group_id": response,
+		},
+	})
+}
+
 func (controller *Group) GetGroupInfoFromLink(c *fiber.Ctx) error {
 	var request domainGroup.GetGroupInfoFromLinkRequest
 	err := c.QueryParser(&request)
@@ -77,7 +112,7 @@ func (controller *Group) LeaveGroup(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	err = controller.Service.LeaveGroup(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -110,6 +145,28 @@ func (controller *Group) AddParticipants(c *fiber.Ctx) error {
 	return controller.manageParticipants(c, whatsmeow.ParticipantChangeAdd, "Success add participants")
 }
 
+func (controller *Group) BulkAddParticipants(c *fiber.Ctx) error {
+	var request domainGroup.BulkAddParticipantsRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	if file, err := c.FormFile("file"); err == nil {
+		request.File = file
+	}
+
+	response, err := controller.Service.BulkAddParticipants(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: fmt.Sprintf("Processed %d participants: %d added, %d invited, %d failed", response.Total, response.Added, response.Invited, response.Failed),
+		Results: response,
+	})
+}
+
 func (controller *Group) DeleteParticipants(c *fiber.Ctx) error {
 	return controller.manageParticipants(c, whatsmeow.ParticipantChangeRemove, "Success delete participants")
 }
@@ -135,7 +192,7 @@ func (controller *Group) ListParticipantRequests(c *fiber.Ctx) error {
 		})
 	}
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	result, err := controller.Service.GetGroupRequestParticipants(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -161,7 +218,7 @@ func (controller *Group) manageParticipants(c *fiber.Ctx, action whatsmeow.Parti
 	var request domainGroup.ParticipantRequest
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 	request.Action = action
 	result, err := controller.Service.ManageParticipant(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -178,7 +235,7 @@ func (controller *Group) handleRequestedParticipants(c *fiber.Ctx, action whatsm
 	var request domainGroup.GroupRequestParticipantsRequest
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 	request.Action = action
 	result, err := controller.Service.ManageGroupRequestParticipants(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -195,7 +252,7 @@ func (controller *Group) SetGroupPhoto(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	file, err := c.FormFile("photo")
 	if err == nil {
@@ -244,7 +301,7 @@ func (controller *Group) SetGroupName(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	err = controller.Service.SetGroupName(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -261,7 +318,7 @@ func (controller *Group) SetGroupLocked(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	err = controller.Service.SetGroupLocked(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -283,7 +340,7 @@ func (controller *Group) SetGroupAnnounce(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	err = controller.Service.SetGroupAnnounce(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -305,7 +362,7 @@ func (controller *Group) SetGroupTopic(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	err = controller.Service.SetGroupTopic(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -328,7 +385,7 @@ func (controller *Group) GroupInfo(c *fiber.Ctx) error {
 	err := c.QueryParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	response, err := controller.Service.GroupInfo(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -346,7 +403,7 @@ func (controller *Group) GetGroupInviteLink(c *fiber.Ctx) error {
 	err := c.QueryParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.GroupID)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
 
 	response, err := controller.Service.GetGroupInviteLink(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -358,3 +415,228 @@ func (controller *Group) GetGroupInviteLink(c *fiber.Ctx) error {
 		Results: response,
 	})
 }
+
+func (controller *Group) SetGroupJoinApproval(c *fiber.Ctx) error {
+	var request domainGroup.SetGroupJoinApprovalRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	err = controller.Service.SetGroupJoinApproval(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	message := "Success disable group join approval"
+	if request.RequireApproval {
+		message = "Success enable group join approval"
+	}
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: message,
+	})
+}
+
+func (controller *Group) SetGroupMemberAddMode(c *fiber.Ctx) error {
+	var request domainGroup.SetGroupMemberAddModeRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	err = controller.Service.SetGroupMemberAddMode(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: fmt.Sprintf("Success set group member add mode to %s", request.Mode),
+	})
+}
+
+func (controller *Group) SetGroupEphemeral(c *fiber.Ctx) error {
+	var request domainGroup.SetGroupEphemeralRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	err = controller.Service.SetGroupEphemeral(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	message := fmt.Sprintf("Success set group disappearing messages to %s", request.Timer)
+	if request.Timer == "off" {
+		message = "Success turn off group disappearing messages"
+	}
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: message,
+	})
+}
+
+func (controller *Group) RevokeGroupInviteLink(c *fiber.Ctx) error {
+	var request domainGroup.RevokeGroupInviteLinkRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	response, err := controller.Service.RevokeGroupInviteLink(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success revoke group invite link",
+		Results: response,
+	})
+}
+
+func (controller *Group) LinkCommunityGroup(c *fiber.Ctx) error {
+	var request domainGroup.LinkCommunityGroupRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.CommunityID))
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	err = controller.Service.LinkGroup(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success link group to community",
+	})
+}
+
+func (controller *Group) UnlinkCommunityGroup(c *fiber.Ctx) error {
+	var request domainGroup.LinkCommunityGroupRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.CommunityID))
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	err = controller.Service.UnlinkGroup(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success unlink group from community",
+	})
+}
+
+func (controller *Group) GetCommunitySubGroups(c *fiber.Ctx) error {
+	var request domainGroup.CommunityRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.CommunityID))
+
+	result, err := controller.Service.GetSubGroups(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get community subgroups",
+		Results: map[string]any{
+			"data": result,
+		},
+	})
+}
+
+func (controller *Group) GetCommunityParticipants(c *fiber.Ctx) error {
+	var request domainGroup.CommunityRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.CommunityID))
+
+	response, err := controller.Service.GetCommunityParticipants(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get community participants",
+		Results: response,
+	})
+}
+
+func (controller *Group) SendCommunityAnnouncement(c *fiber.Ctx) error {
+	var request domainGroup.CommunityAnnouncementRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.CommunityID))
+
+	response, err := controller.Service.SendCommunityAnnouncement(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success send community announcement",
+		Results: response,
+	})
+}
+
+func (controller *Group) GetGroupMembership(c *fiber.Ctx) error {
+	var request domainGroup.GroupMembershipRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	response, err := controller.Service.GetGroupMembership(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get group membership",
+		Results: response,
+	})
+}
+
+func (controller *Group) GetGroupGrowth(c *fiber.Ctx) error {
+	var request domainGroup.GroupMembershipPeriodRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	response, err := controller.Service.GetGroupGrowth(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get group growth",
+		Results: response,
+	})
+}
+
+func (controller *Group) GetGroupChurn(c *fiber.Ctx) error {
+	var request domainGroup.GroupMembershipPeriodRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	response, err := controller.Service.GetGroupChurn(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get group churn",
+		Results: response,
+	})
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	domainUser "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/user"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadDeclarativeProfile reads a profile from a YAML file. JSON is a subset of YAML, so JSON files work as well.
+func LoadDeclarativeProfile(path string) (profile domainUser.DeclarativeProfile, err error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return profile, fmt.Errorf("failed to read profile file: %w", err)
+	}
+
+	if err = yaml.Unmarshal(content, &profile); err != nil {
+		return profile, fmt.Errorf("failed to parse profile file %s: %w", path, err)
+	}
+
+	return profile, nil
+}
+
+// SetDeclarativeProfile applies the profile file whenever an account connects, so every deployment ends up
+// with the same branding no matter when the device is linked. Each account gets the profile once per process.
+func SetDeclarativeProfile(service domainUser.IUserUsecase, path string) {
+	if path == "" {
+		return
+	}
+
+	profile, err := LoadDeclarativeProfile(path)
+	if err == nil {
+		err = validations.ValidateApplyProfile(context.Background(), profile)
+	}
+	if err != nil {
+		logrus.Errorf("Declarative profile not applied: %v", err)
+		return
+	}
+
+	var (
+		mu      sync.Mutex
+		applied = map[string]bool{}
+	)
+	apply := func() {
+		cli := whatsapp.GetClient()
+		if cli == nil || !cli.IsConnected() || !cli.IsLoggedIn() || cli.Store.ID == nil {
+			return
+		}
+
+		// Reconnects of the same account keep the profile applied before
+		account := cli.Store.ID.ToNonAD().String()
+		mu.Lock()
+		defer mu.Unlock()
+		if applied[account] {
+			return
+		}
+		applied[account] = applyDeclarativeProfile(service, path, profile)
+	}
+
+	whatsapp.OnConnected(apply)
+	// The connection may have been established before the hook was registered
+	apply()
+}
+
+// applyDeclarativeProfile reports whether the profile was applied, a failed attempt is retried on the next connect
+func applyDeclarativeProfile(service domainUser.IUserUsecase, path string, profile domainUser.DeclarativeProfile) (ok bool) {
+	defer func() {
+		// The usecase panics when the connection drops in the meantime
+		if r := recover(); r != nil {
+			logrus.Errorf("Declarative profile not applied: %v", r)
+			ok = false
+		}
+	}()
+
+	if err := service.ApplyProfile(context.Background(), profile); err != nil {
+		logrus.Errorf("Declarative profile %s partially applied: %v", path, err)
+		return false
+	}
+
+	logrus.Infof("Applied declarative profile %s", path)
+	return true
+}
@@ -21,7 +21,11 @@ func InitRestMessage(app fiber.Router, service domainMessage.IMessageUsecase) Me
 	app.Post("/message/:message_id/read", rest.MarkAsRead)
 	app.Post("/message/:message_id/star", rest.StarMessage)
 	app.Post("/message/:message_id/unstar", rest.UnstarMessage)
+	app.Post("/message/:message_id/pin", rest.PinMessage)
+	app.Post("/message/:message_id/unpin", rest.UnpinMessage)
 	app.Get("/message/:message_id/download", rest.DownloadMedia)
+	app.Get("/message/:message_id/receipts", rest.GetMessageReceipts)
+	app.Get("/poll/:message_id/results", rest.GetPollResults)
 	return rest
 }
 
@@ -31,7 +35,7 @@ func (controller *Message) RevokeMessage(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.RevokeMessage(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -50,7 +54,7 @@ func (controller *Message) DeleteMessage(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	err = controller.Service.DeleteMessage(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -69,7 +73,7 @@ func (controller *Message) UpdateMessage(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.UpdateMessage(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -88,7 +92,7 @@ func (controller *Message) ReactMessage(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.ReactMessage(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -107,7 +111,7 @@ func (controller *Message) MarkAsRead(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.MarkAsRead(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -126,7 +130,7 @@ func (controller *Message) StarMessage(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 	request.IsStarred = true
 
 	err = controller.Service.StarMessage(c.UserContext(), request)
@@ -146,7 +150,7 @@ func (controller *Message) UnstarMessage(c *fiber.Ctx) error {
 	utils.PanicIfNeeded(err)
 
 	request.MessageID = c.Params("message_id")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 	request.IsStarred = false
 	err = controller.Service.StarMessage(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -159,12 +163,52 @@ func (controller *Message) UnstarMessage(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *Message) PinMessage(c *fiber.Ctx) error {
+	var request domainMessage.PinMessageRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.MessageID = c.Params("message_id")
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+	request.IsPinned = true
+
+	response, err := controller.Service.PinMessage(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: response.Status,
+		Results: response,
+	})
+}
+
+func (controller *Message) UnpinMessage(c *fiber.Ctx) error {
+	var request domainMessage.PinMessageRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.MessageID = c.Params("message_id")
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+	request.IsPinned = false
+
+	response, err := controller.Service.PinMessage(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: response.Status,
+		Results: response,
+	})
+}
+
 func (controller *Message) DownloadMedia(c *fiber.Ctx) error {
 	var request domainMessage.DownloadMediaRequest
 
 	request.MessageID = c.Params("message_id")
 	request.Phone = c.Query("phone")
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.DownloadMedia(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -176,3 +220,33 @@ func (controller *Message) DownloadMedia(c *fiber.Ctx) error {
 		Results: response,
 	})
 }
+
+func (controller *Message) GetPollResults(c *fiber.Ctx) error {
+	var request domainMessage.PollResultsRequest
+	request.MessageID = c.Params("message_id")
+
+	response, err := controller.Service.GetPollResults(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get poll results",
+		Results: response,
+	})
+}
+
+func (controller *Message) GetMessageReceipts(c *fiber.Ctx) error {
+	var request domainMessage.MessageReceiptsRequest
+	request.MessageID = c.Params("message_id")
+
+	response, err := controller.Service.GetMessageReceipts(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get message receipts",
+		Results: response,
+	})
+}
@@ -12,7 +12,15 @@ type Newsletter struct {
 
 func InitRestNewsletter(app fiber.Router, service domainNewsletter.INewsletterUsecase) Newsletter {
 	rest := Newsletter{Service: service}
+	app.Post("/newsletter", rest.Create)
 	app.Post("/newsletter/unfollow", rest.Unfollow)
+	app.Post("/newsletter/follow", rest.Follow)
+	app.Get("/newsletter/info", rest.GetInfo)
+	app.Post("/newsletter/:newsletter_id/update", rest.Update)
+	app.Post("/newsletter/:newsletter_id/mute", rest.Mute)
+	app.Post("/newsletter/:newsletter_id/unmute", rest.Unmute)
+	app.Post("/newsletter/:newsletter_id/post", rest.Post)
+	app.Get("/newsletter/:newsletter_id/messages", rest.GetMessages)
 	return rest
 }
 
@@ -30,3 +38,150 @@ func (controller *Newsletter) Unfollow(c *fiber.Ctx) error {
 		Message: "Success unfollow newsletter",
 	})
 }
+
+func (controller *Newsletter) Create(c *fiber.Ctx) error {
+	var request domainNewsletter.CreateNewsletterRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	if picture, errFile := c.FormFile("picture"); errFile == nil {
+		request.Picture = picture
+	}
+
+	response, err := controller.Service.Create(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success create newsletter",
+		Results: response,
+	})
+}
+
+func (controller *Newsletter) Update(c *fiber.Ctx) error {
+	var request domainNewsletter.UpdateNewsletterRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.NewsletterID = c.Params("newsletter_id")
+	if picture, errFile := c.FormFile("picture"); errFile == nil {
+		request.Picture = picture
+	}
+
+	response, err := controller.Service.Update(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success update newsletter",
+		Results: response,
+	})
+}
+
+func (controller *Newsletter) Follow(c *fiber.Ctx) error {
+	var request domainNewsletter.FollowNewsletterRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	response, err := controller.Service.Follow(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success follow newsletter",
+		Results: response,
+	})
+}
+
+func (controller *Newsletter) Mute(c *fiber.Ctx) error {
+	request := domainNewsletter.MuteNewsletterRequest{
+		NewsletterID: c.Params("newsletter_id"),
+		Mute:         true,
+	}
+
+	err := controller.Service.Mute(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success mute newsletter",
+	})
+}
+
+func (controller *Newsletter) Unmute(c *fiber.Ctx) error {
+	request := domainNewsletter.MuteNewsletterRequest{
+		NewsletterID: c.Params("newsletter_id"),
+		Mute:         false,
+	}
+
+	err := controller.Service.Mute(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success unmute newsletter",
+	})
+}
+
+func (controller *Newsletter) GetInfo(c *fiber.Ctx) error {
+	var request domainNewsletter.GetNewsletterInfoRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	response, err := controller.Service.GetInfo(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get newsletter info",
+		Results: response,
+	})
+}
+
+func (controller *Newsletter) Post(c *fiber.Ctx) error {
+	var request domainNewsletter.PostNewsletterRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.NewsletterID = c.Params("newsletter_id")
+	if image, errFile := c.FormFile("image"); errFile == nil {
+		request.Image = image
+	}
+	if video, errFile := c.FormFile("video"); errFile == nil {
+		request.Video = video
+	}
+
+	response, err := controller.Service.Post(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success post to newsletter",
+		Results: response,
+	})
+}
+
+func (controller *Newsletter) GetMessages(c *fiber.Ctx) error {
+	var request domainNewsletter.GetNewsletterMessagesRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.NewsletterID = c.Params("newsletter_id")
+
+	response, err := controller.Service.GetMessages(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get newsletter messages",
+		Results: response,
+	})
+}
@@ -17,10 +17,12 @@ func InitRestSend(app fiber.Router, service domainSend.ISendUsecase) Send {
 	app.Post("/send/file", rest.SendFile)
 	app.Post("/send/video", rest.SendVideo)
 	app.Post("/send/contact", rest.SendContact)
+	app.Post("/send/group-invite", rest.SendGroupInvite)
 	app.Post("/send/link", rest.SendLink)
 	app.Post("/send/location", rest.SendLocation)
 	app.Post("/send/audio", rest.SendAudio)
 	app.Post("/send/poll", rest.SendPoll)
+	app.Post("/send/template", rest.SendTemplate)
 	app.Post("/send/presence", rest.SendPresence)
 	app.Post("/send/chat-presence", rest.SendChatPresence)
 	return rest
@@ -31,7 +33,7 @@ func (controller *Send) SendText(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendText(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -56,7 +58,7 @@ func (controller *Send) SendImage(c *fiber.Ctx) error {
 		request.Image = file
 	}
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendImage(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -74,11 +76,12 @@ func (controller *Send) SendFile(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	file, err := c.FormFile("file")
-	utils.PanicIfNeeded(err)
+	// Try to get file but ignore error if not provided
+	if file, errFile := c.FormFile("file"); errFile == nil {
+		request.File = file
+	}
 
-	request.File = file
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendFile(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -101,7 +104,7 @@ func (controller *Send) SendVideo(c *fiber.Ctx) error {
 		request.Video = videoFile
 	}
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendVideo(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -119,7 +122,7 @@ func (controller *Send) SendContact(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendContact(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -132,12 +135,31 @@ func (controller *Send) SendContact(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *Send) SendGroupInvite(c *fiber.Ctx) error {
+	var request domainSend.GroupInviteRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.GroupID))
+
+	response, err := controller.Service.SendGroupInvite(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: response.Status,
+		Results: response,
+	})
+}
+
 func (controller *Send) SendLink(c *fiber.Ctx) error {
 	var request domainSend.LinkRequest
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendLink(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -155,7 +177,7 @@ func (controller *Send) SendLocation(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendLocation(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -178,7 +200,7 @@ func (controller *Send) SendAudio(c *fiber.Ctx) error {
 		request.Audio = audioFile
 	}
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendAudio(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -196,7 +218,7 @@ func (controller *Send) SendPoll(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendPoll(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -209,6 +231,24 @@ func (controller *Send) SendPoll(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *Send) SendTemplate(c *fiber.Ctx) error {
+	var request domainSend.TemplateRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+
+	response, err := controller.Service.SendTemplate(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: response.Status,
+		Results: response,
+	})
+}
+
 func (controller *Send) SendPresence(c *fiber.Ctx) error {
 	var request domainSend.PresenceRequest
 	err := c.BodyParser(&request)
@@ -230,7 +270,7 @@ func (controller *Send) SendChatPresence(c *fiber.Ctx) error {
 	err := c.BodyParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.SendChatPresence(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
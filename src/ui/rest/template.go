@@ -0,0 +1,113 @@
+package rest
+
+import (
+	domainTemplate "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/template"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Template struct {
+	Service domainTemplate.ITemplateUsecase
+}
+
+func InitRestTemplate(app fiber.Router, service domainTemplate.ITemplateUsecase) Template {
+	rest := Template{Service: service}
+	app.Get("/templates", rest.ListTemplates)
+	app.Post("/templates", rest.SaveTemplate)
+	app.Get("/templates/:name", rest.GetTemplate)
+	app.Delete("/templates/:name", rest.DeleteTemplate)
+	app.Get("/templates/:name/versions", rest.ListTemplateVersions)
+	app.Post("/templates/:name/preview", rest.PreviewTemplate)
+	return rest
+}
+
+func (controller *Template) ListTemplates(c *fiber.Ctx) error {
+	response, err := controller.Service.ListTemplates(c.UserContext())
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get templates",
+		Results: response,
+	})
+}
+
+func (controller *Template) SaveTemplate(c *fiber.Ctx) error {
+	var request domainTemplate.SaveTemplateRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	response, err := controller.Service.SaveTemplate(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success save template",
+		Results: response,
+	})
+}
+
+func (controller *Template) GetTemplate(c *fiber.Ctx) error {
+	var request domainTemplate.GetTemplateRequest
+	request.Name = c.Params("name")
+	request.Version = c.QueryInt("version", 0)
+
+	response, err := controller.Service.GetTemplate(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get template",
+		Results: response,
+	})
+}
+
+func (controller *Template) ListTemplateVersions(c *fiber.Ctx) error {
+	var request domainTemplate.GetTemplateRequest
+	request.Name = c.Params("name")
+
+	response, err := controller.Service.ListTemplateVersions(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get template versions",
+		Results: response,
+	})
+}
+
+func (controller *Template) DeleteTemplate(c *fiber.Ctx) error {
+	var request domainTemplate.GetTemplateRequest
+	request.Name = c.Params("name")
+
+	err := controller.Service.DeleteTemplate(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success delete template",
+	})
+}
+
+func (controller *Template) PreviewTemplate(c *fiber.Ctx) error {
+	var request domainTemplate.PreviewTemplateRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	request.Name = c.Params("name")
+
+	response, err := controller.Service.PreviewTemplate(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success preview template",
+		Results: response,
+	})
+}
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"fmt"
+
 	domainUser "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/user"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/gofiber/fiber/v2"
@@ -16,12 +18,21 @@ func InitRestUser(app fiber.Router, service domainUser.IUserUsecase) User {
 	app.Get("/user/avatar", rest.UserAvatar)
 	app.Post("/user/avatar", rest.UserChangeAvatar)
 	app.Post("/user/pushname", rest.UserChangePushName)
+	app.Post("/user/about", rest.UserChangeAbout)
 	app.Get("/user/my/privacy", rest.UserMyPrivacySetting)
+	app.Post("/user/my/privacy", rest.UserUpdatePrivacySetting)
 	app.Get("/user/my/groups", rest.UserMyListGroups)
 	app.Get("/user/my/newsletters", rest.UserMyListNewsletter)
 	app.Get("/user/my/contacts", rest.UserMyListContacts)
 	app.Get("/user/check", rest.UserCheck)
+	app.Post("/user/check/bulk", rest.UserBulkCheck)
 	app.Get("/user/business-profile", rest.UserBusinessProfile)
+	app.Post("/user/block", rest.UserBlock)
+	app.Post("/user/unblock", rest.UserUnblock)
+	app.Get("/user/blocklist", rest.UserBlocklist)
+	app.Post("/user/presence/subscribe", rest.UserSubscribePresence)
+	app.Post("/user/presence/unsubscribe", rest.UserUnsubscribePresence)
+	app.Get("/user/presence", rest.UserPresence)
 
 	return rest
 }
@@ -31,7 +42,7 @@ func (controller *User) UserInfo(c *fiber.Ctx) error {
 	err := c.QueryParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.Info(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -49,7 +60,7 @@ func (controller *User) UserAvatar(c *fiber.Ctx) error {
 	err := c.QueryParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.Avatar(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -92,6 +103,22 @@ func (controller *User) UserMyPrivacySetting(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *User) UserUpdatePrivacySetting(c *fiber.Ctx) error {
+	var request domainUser.UpdatePrivacySettingRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	response, err := controller.Service.UpdatePrivacySetting(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success update privacy setting",
+		Results: response,
+	})
+}
+
 func (controller *User) UserMyListGroups(c *fiber.Ctx) error {
 	response, err := controller.Service.MyListGroups(c.UserContext())
 	utils.PanicIfNeeded(err)
@@ -143,6 +170,21 @@ func (controller *User) UserChangePushName(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *User) UserChangeAbout(c *fiber.Ctx) error {
+	var request domainUser.ChangeAboutRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	err = controller.Service.ChangeAbout(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success change about",
+	})
+}
+
 func (controller *User) UserCheck(c *fiber.Ctx) error {
 	var request domainUser.CheckRequest
 	err := c.QueryParser(&request)
@@ -159,12 +201,32 @@ func (controller *User) UserCheck(c *fiber.Ctx) error {
 	})
 }
 
+func (controller *User) UserBulkCheck(c *fiber.Ctx) error {
+	var request domainUser.BulkCheckRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	if file, err := c.FormFile("file"); err == nil {
+		request.File = file
+	}
+
+	response, err := controller.Service.BulkIsOnWhatsApp(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: fmt.Sprintf("Checked %d numbers: %d on WhatsApp, %d not on WhatsApp, %d invalid", response.Total, response.OnWhatsApp, response.NotOnWhatsApp, response.Invalid),
+		Results: response,
+	})
+}
+
 func (controller *User) UserBusinessProfile(c *fiber.Ctx) error {
 	var request domainUser.BusinessProfileRequest
 	err := c.QueryParser(&request)
 	utils.PanicIfNeeded(err)
 
-	utils.SanitizePhone(&request.Phone)
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
 
 	response, err := controller.Service.BusinessProfile(c.UserContext(), request)
 	utils.PanicIfNeeded(err)
@@ -176,3 +238,105 @@ func (controller *User) UserBusinessProfile(c *fiber.Ctx) error {
 		Results: response,
 	})
 }
+
+func (controller *User) UserBlock(c *fiber.Ctx) error {
+	var request domainUser.BlockContactRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+
+	response, err := controller.Service.BlockContact(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success block contact",
+		Results: response,
+	})
+}
+
+func (controller *User) UserUnblock(c *fiber.Ctx) error {
+	var request domainUser.BlockContactRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+
+	response, err := controller.Service.UnblockContact(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success unblock contact",
+		Results: response,
+	})
+}
+
+func (controller *User) UserBlocklist(c *fiber.Ctx) error {
+	response, err := controller.Service.GetBlocklist(c.UserContext())
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get blocklist",
+		Results: response,
+	})
+}
+
+func (controller *User) UserSubscribePresence(c *fiber.Ctx) error {
+	var request domainUser.SubscribePresenceRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+
+	response, err := controller.Service.SubscribePresence(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: fmt.Sprintf("Subscribed to presence of %s", response.JID),
+		Results: response,
+	})
+}
+
+func (controller *User) UserUnsubscribePresence(c *fiber.Ctx) error {
+	var request domainUser.SubscribePresenceRequest
+	err := c.BodyParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+
+	response, err := controller.Service.UnsubscribePresence(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: fmt.Sprintf("Unsubscribed from presence of %s", response.JID),
+		Results: response,
+	})
+}
+
+func (controller *User) UserPresence(c *fiber.Ctx) error {
+	var request domainUser.PresenceRequest
+	err := c.QueryParser(&request)
+	utils.PanicIfNeeded(err)
+
+	utils.PanicIfNeeded(utils.SanitizePhone(&request.Phone))
+
+	response, err := controller.Service.GetPresence(c.UserContext(), request)
+	utils.PanicIfNeeded(err)
+
+	return c.JSON(utils.ResponseData{
+		Status:  200,
+		Code:    "SUCCESS",
+		Message: "Success get presence",
+		Results: response,
+	})
+}
@@ -12,6 +12,7 @@ import (
 	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
 	fiberUtils "github.com/gofiber/fiber/v2/utils"
 	_ "github.com/mattn/go-sqlite3"
@@ -130,6 +131,10 @@ func (service *serviceApp) LoginWithCode(ctx context.Context, phoneNumber string
 		return loginCode, err
 	}
 
+	if phoneNumber, err = utils.NormalizePhoneNumber(phoneNumber); err != nil {
+		return loginCode, err
+	}
+
 	client := whatsapp.GetClient()
 	// detect is already logged in
 	if client.Store.ID != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
 	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/appstate"
 	"go.mau.fi/whatsmeow/types"
 )
@@ -250,6 +251,39 @@ func (service serviceChat) GetChatMessages(ctx context.Context, request domainCh
 		totalCount = 0
 	}
 
+	// Group the receipts of outgoing messages to compute their status
+	var sentIDs []string
+	messageIDs := make([]string, 0, len(messages))
+	for _, message := range messages {
+		messageIDs = append(messageIDs, message.ID)
+		if message.IsFromMe {
+			sentIDs = append(sentIDs, message.ID)
+		}
+	}
+	receiptsByMessage := make(map[string][]*domainChatStorage.MessageReceipt)
+	receipts, err := service.chatStorageRepo.GetMessageReceipts(request.ChatJID, sentIDs...)
+	if err != nil {
+		logrus.WithError(err).WithField("chat_jid", request.ChatJID).Error("Failed to get message receipts")
+		// Continue with every outgoing message reported as sent
+	}
+	for _, receipt := range receipts {
+		receiptsByMessage[receipt.MessageID] = append(receiptsByMessage[receipt.MessageID], receipt)
+	}
+	recipients := 0
+	if len(sentIDs) > 0 {
+		recipients = messageRecipients(request.ChatJID)
+	}
+
+	reactions, err := service.chatStorageRepo.GetMessageReactions(request.ChatJID, messageIDs...)
+	if err != nil {
+		logrus.WithError(err).WithField("chat_jid", request.ChatJID).Error("Failed to get message reactions")
+		// Continue without reactions
+	}
+	reactionsByMessage := make(map[string][]*domainChatStorage.MessageReaction)
+	for _, reaction := range reactions {
+		reactionsByMessage[reaction.MessageID] = append(reactionsByMessage[reaction.MessageID], reaction)
+	}
+
 	// Convert entities to domain objects
 	messageInfos := make([]domainChat.MessageInfo, 0, len(messages))
 	for _, message := range messages {
@@ -267,6 +301,14 @@ func (service serviceChat) GetChatMessages(ctx context.Context, request domainCh
 			CreatedAt:  message.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:  message.UpdatedAt.Format(time.RFC3339),
 		}
+		if message.EditedAt != nil {
+			messageInfo.IsEdited = true
+			messageInfo.EditedAt = message.EditedAt.Format(time.RFC3339)
+		}
+		if message.IsFromMe {
+			messageInfo.Status = utils.MessageStatus(receiptsByMessage[message.ID], recipients)
+		}
+		messageInfo.Reactions = groupReactions(reactionsByMessage[message.ID])
 		messageInfos = append(messageInfos, messageInfo)
 	}
 
@@ -301,6 +343,23 @@ func (service serviceChat) GetChatMessages(ctx context.Context, request domainCh
 	return response, nil
 }
 
+// groupReactions counts the reactions to a message per emoji, in the order each emoji was first used
+func groupReactions(reactions []*domainChatStorage.MessageReaction) []domainChat.ReactionInfo {
+	var grouped []domainChat.ReactionInfo
+	index := make(map[string]int)
+	for _, reaction := range reactions {
+		i, ok := index[reaction.Emoji]
+		if !ok {
+			i = len(grouped)
+			index[reaction.Emoji] = i
+			grouped = append(grouped, domainChat.ReactionInfo{Emoji: reaction.Emoji})
+		}
+		grouped[i].Count++
+		grouped[i].Reactors = append(grouped[i].Reactors, reaction.Reactor)
+	}
+	return grouped
+}
+
 func (service serviceChat) PinChat(ctx context.Context, request domainChat.PinChatRequest) (response domainChat.PinChatResponse, err error) {
 	if err = validations.ValidatePinChat(ctx, &request); err != nil {
 		return response, err
@@ -465,3 +524,159 @@ func (service serviceChat) MarkChatAsRead(ctx context.Context, request domainCha
 
 	return response, nil
 }
+
+func (service serviceChat) SetDisappearingTimer(ctx context.Context, request domainChat.SetDisappearingTimerRequest) (response domainChat.SetDisappearingTimerResponse, err error) {
+	if err = validations.ValidateSetDisappearingTimer(ctx, &request); err != nil {
+		return response, err
+	}
+
+	targetJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.ChatJID)
+	if err != nil {
+		return response, err
+	}
+
+	timer, _ := whatsmeow.ParseDisappearingTimerString(request.Timer)
+	if err = whatsapp.GetClient().SetDisappearingTimer(targetJID, timer); err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"chat_jid": request.ChatJID,
+			"timer":    request.Timer,
+		}).Error("Failed to set disappearing timer")
+		return response, err
+	}
+
+	// Groups echo the change as a group info event, but private chats only see our own protocol message
+	expiration := uint32(timer.Seconds())
+	if err = service.chatStorageRepo.UpdateChatEphemeralExpiration(targetJID.String(), expiration); err != nil {
+		logrus.WithError(err).WithField("chat_jid", targetJID.String()).Warn("Failed to store disappearing timer")
+	}
+
+	response.Status = "success"
+	response.ChatJID = targetJID.String()
+	response.Timer = request.Timer
+	response.EphemeralExpiration = expiration
+	if timer == whatsmeow.DisappearingTimerOff {
+		response.Message = "Disappearing messages turned off"
+	} else {
+		response.Message = fmt.Sprintf("Disappearing messages set to %s", request.Timer)
+	}
+
+	return response, nil
+}
+
+func (service serviceChat) SetDefaultDisappearingTimer(ctx context.Context, request domainChat.SetDefaultDisappearingTimerRequest) (response domainChat.SetDisappearingTimerResponse, err error) {
+	if err = validations.ValidateSetDefaultDisappearingTimer(ctx, &request); err != nil {
+		return response, err
+	}
+
+	utils.MustLogin(whatsapp.GetClient())
+
+	timer, _ := whatsmeow.ParseDisappearingTimerString(request.Timer)
+	if err = whatsapp.GetClient().SetDefaultDisappearingTimer(timer); err != nil {
+		logrus.WithError(err).WithField("timer", request.Timer).Error("Failed to set default disappearing timer")
+		return response, err
+	}
+
+	// WhatsApp does not report the default back, so keep it for messages sent to new chats
+	if err := service.chatStorageRepo.StoreDefaultEphemeralExpiration(uint32(timer.Seconds())); err != nil {
+		logrus.WithError(err).Warn("Failed to store default disappearing timer")
+	}
+
+	response.Status = "success"
+	response.Timer = request.Timer
+	response.EphemeralExpiration = uint32(timer.Seconds())
+	if timer == whatsmeow.DisappearingTimerOff {
+		response.Message = "Default disappearing timer turned off"
+	} else {
+		response.Message = fmt.Sprintf("Default disappearing timer for new chats set to %s", request.Timer)
+	}
+
+	return response, nil
+}
+
+func (service serviceChat) GetPinnedMessages(ctx context.Context, request domainChat.GetPinnedMessagesRequest) (response domainChat.GetPinnedMessagesResponse, err error) {
+	if err = validations.ValidateGetPinnedMessages(ctx, &request); err != nil {
+		return response, err
+	}
+
+	pins, err := service.chatStorageRepo.GetPinnedMessages(request.ChatJID)
+	if err != nil {
+		logrus.WithError(err).WithField("chat_jid", request.ChatJID).Error("Failed to get pinned messages")
+		return response, err
+	}
+
+	response.ChatJID = request.ChatJID
+	response.Data = make([]domainChat.PinnedMessageInfo, 0, len(pins))
+	for _, pin := range pins {
+		info := domainChat.PinnedMessageInfo{
+			MessageID: pin.MessageID,
+			PinnedBy:  pin.PinnedBy,
+			PinnedAt:  pin.PinnedAt.Format(time.RFC3339),
+			ExpiresAt: pin.ExpiresAt.Format(time.RFC3339),
+		}
+
+		// The pinned message itself may not be stored, e.g. when it predates the history sync
+		message, err := service.chatStorageRepo.GetMessageByID(pin.MessageID)
+		if err != nil {
+			logrus.WithError(err).WithField("message_id", pin.MessageID).Warn("Failed to get pinned message")
+		} else if message != nil && message.ChatJID == pin.ChatJID {
+			info.SenderJID = message.Sender
+			info.Content = message.Content
+			info.MediaType = message.MediaType
+		}
+
+		response.Data = append(response.Data, info)
+	}
+
+	return response, nil
+}
+
+func (service serviceChat) ListCalls(ctx context.Context, request domainChat.ListCallsRequest) (response domainChat.ListCallsResponse, err error) {
+	if err = validations.ValidateListCalls(ctx, &request); err != nil {
+		return response, err
+	}
+
+	filter := &domainChatStorage.CallFilter{
+		Caller: request.Phone,
+		Limit:  request.Limit,
+		Offset: request.Offset,
+	}
+
+	calls, err := service.chatStorageRepo.GetCalls(filter)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get call log")
+		return response, err
+	}
+
+	total, err := service.chatStorageRepo.CountCalls(filter)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to count call log")
+		return response, err
+	}
+
+	response.Data = make([]domainChat.CallInfo, 0, len(calls))
+	for _, call := range calls {
+		info := domainChat.CallInfo{
+			CallID:       call.ID,
+			From:         call.Caller,
+			GroupJID:     call.GroupJID,
+			IsGroup:      call.IsGroup,
+			IsVideo:      call.IsVideo,
+			Status:       call.Status,
+			AutoRejected: call.AutoRejected,
+			Reason:       call.Reason,
+			StartedAt:    call.StartedAt.Format(time.RFC3339),
+		}
+		if call.EndedAt != nil {
+			info.EndedAt = call.EndedAt.Format(time.RFC3339)
+		}
+		response.Data = append(response.Data, info)
+	}
+
+	response.Pagination = domainChat.PaginationResponse{
+		Limit:  request.Limit,
+		Offset: request.Offset,
+		Total:  int(total),
+	}
+
+	return response, nil
+}
@@ -3,23 +3,50 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
-	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	domainGroup "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/group"
+	domainSend "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/send"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
 	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
 	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
 )
 
-type serviceGroup struct{}
+const (
+	// defaultBulkAddBatchSize and defaultBulkAddBatchDelay pace bulk adds when the caller does not
+	defaultBulkAddBatchSize  = 20
+	defaultBulkAddBatchDelay = 5 * time.Second
+	// maxBulkAddParticipants matches the maximum size of a WhatsApp group
+	maxBulkAddParticipants = 1024
 
-func NewGroupService() domainGroup.IGroupUsecase {
-	return &serviceGroup{}
+	bulkParticipantAdded   = "added"
+	bulkParticipantInvited = "invited"
+	bulkParticipantFailed  = "failed"
+)
+
+// defaultGroupMembershipPeriod is used for growth and churn reports when no start is given
+const defaultGroupMembershipPeriod = 30 * 24 * time.Hour
+
+type serviceGroup struct {
+	sendService     domainSend.ISendUsecase
+	chatStorageRepo domainChatStorage.IChatStorageRepository
+}
+
+func NewGroupService(sendService domainSend.ISendUsecase, chatStorageRepo domainChatStorage.IChatStorageRepository) domainGroup.IGroupUsecase {
+	return &serviceGroup{
+		sendService:     sendService,
+		chatStorageRepo: chatStorageRepo,
+	}
 }
 
 func (service serviceGroup) JoinGroupWithLink(ctx context.Context, request domainGroup.JoinGroupWithLinkRequest) (groupID string, err error) {
@@ -35,6 +62,37 @@ func (service serviceGroup) JoinGroupWithLink(ctx context.Context, request domai
 	return jid.String(), nil
 }
 
+func (service serviceGroup) AcceptGroupInvite(ctx context.Context, request domainGroup.AcceptGroupInviteRequest) (groupID string, err error) {
+	if err = validations.ValidateAcceptGroupInvite(ctx, request); err != nil {
+		return groupID, err
+	}
+	utils.MustLogin(whatsapp.GetClient())
+
+	groupJID, err := utils.ParseJID(request.GroupID)
+	if err != nil {
+		return groupID, err
+	}
+
+	inviterJID, err := utils.ParseJID(request.Inviter)
+	if err != nil {
+		return groupID, err
+	}
+
+	// Invite cards without an expiration share the group invite link instead of a personal invite
+	if request.InviteExpiration == 0 {
+		joinedJID, err := whatsapp.GetClient().JoinGroupWithLink(request.InviteCode)
+		if err != nil {
+			return groupID, err
+		}
+		return joinedJID.String(), nil
+	}
+
+	if err = whatsapp.GetClient().JoinGroupWithInvite(groupJID, inviterJID, request.InviteCode, request.InviteExpiration); err != nil {
+		return groupID, err
+	}
+	return groupJID.String(), nil
+}
+
 func (service serviceGroup) LeaveGroup(ctx context.Context, request domainGroup.LeaveGroupRequest) (err error) {
 	if err = validations.ValidateLeaveGroup(ctx, request); err != nil {
 		return err
@@ -62,9 +120,16 @@ func (service serviceGroup) CreateGroup(ctx context.Context, request domainGroup
 	groupConfig := whatsmeow.ReqCreateGroup{
 		Name:              request.Title,
 		Participants:      participantsJID,
-		GroupParent:       types.GroupParent{},
+		GroupParent:       types.GroupParent{IsParent: request.IsCommunity},
 		GroupLinkedParent: types.GroupLinkedParent{},
 	}
+	if request.CommunityID != "" {
+		communityJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.CommunityID)
+		if err != nil {
+			return groupID, err
+		}
+		groupConfig.LinkedParentJID = communityJID
+	}
 
 	groupInfo, err := whatsapp.GetClient().CreateGroup(groupConfig)
 	if err != nil {
@@ -204,10 +269,248 @@ func (service serviceGroup) ManageGroupRequestParticipants(ctx context.Context,
 	return result, nil
 }
 
+func (service serviceGroup) BulkAddParticipants(ctx context.Context, request domainGroup.BulkAddParticipantsRequest) (response domainGroup.BulkAddParticipantsResponse, err error) {
+	if err = validations.ValidateBulkAddParticipants(ctx, request); err != nil {
+		return response, err
+	}
+	utils.MustLogin(whatsapp.GetClient())
+
+	groupJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
+	if err != nil {
+		return response, err
+	}
+
+	groupInfo, err := whatsapp.GetClient().GetGroupInfo(groupJID)
+	if err != nil {
+		return response, err
+	}
+
+	numbers, err := bulkParticipantNumbers(request)
+	if err != nil {
+		return response, err
+	}
+	if len(numbers) > maxBulkAddParticipants {
+		return response, pkgError.ValidationError(fmt.Sprintf("participants: cannot add more than %d participants at once.", maxBulkAddParticipants))
+	}
+
+	batchSize := request.BatchSize
+	if batchSize == 0 {
+		batchSize = defaultBulkAddBatchSize
+	}
+	batchDelay := defaultBulkAddBatchDelay
+	if request.BatchDelay > 0 {
+		batchDelay = time.Duration(request.BatchDelay) * time.Second
+	}
+
+	invite := participantInvite{caption: request.InviteMessage, thumbnail: groupInviteThumbnail(groupJID)}
+
+	response.GroupID = groupJID.String()
+	response.Total = len(numbers)
+	response.Results = []domainGroup.BulkParticipantResult{}
+
+	for start := 0; start < len(numbers); start += batchSize {
+		if start > 0 {
+			select {
+			case <-ctx.Done():
+				return response, ctx.Err()
+			case <-time.After(batchDelay):
+			}
+		}
+
+		end := min(start+batchSize, len(numbers))
+		results := service.bulkAddBatch(ctx, groupInfo, numbers[start:end], invite)
+		response.Results = append(response.Results, results...)
+		logrus.Infof("Bulk add to group %s: processed %d of %d participants", groupJID, end, len(numbers))
+	}
+
+	for _, result := range response.Results {
+		switch result.Status {
+		case bulkParticipantAdded:
+			response.Added++
+		case bulkParticipantInvited:
+			response.Invited++
+		default:
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
+
+// bulkParticipantNumbers merges the numbers of the uploaded CSV with the participants of the request
+func bulkParticipantNumbers(request domainGroup.BulkAddParticipantsRequest) ([]string, error) {
+	var numbers []string
+	if request.File != nil {
+		file, err := request.File.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		if numbers, err = utils.ParsePhoneNumbersCSV(file); err != nil {
+			return nil, pkgError.ValidationError(fmt.Sprintf("file: %s.", err.Error()))
+		}
+	}
+
+	for _, participant := range request.Participants {
+		numbers = append(numbers, strings.TrimSpace(participant))
+	}
+
+	// Normalize to E.164 so the same number written differently is added once; invalid numbers
+	// are kept as written and reported by the batch
+	seen := make(map[string]bool, len(numbers))
+	unique := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		if phone, err := utils.NormalizePhoneNumber(number); err == nil {
+			number = phone
+		}
+		if !seen[number] {
+			seen[number] = true
+			unique = append(unique, number)
+		}
+	}
+
+	return unique, nil
+}
+
+// bulkAddBatch adds one batch of numbers to the group, inviting everyone whose privacy settings prevent adding them
+func (service serviceGroup) bulkAddBatch(ctx context.Context, groupInfo *types.GroupInfo, numbers []string, invite participantInvite) []domainGroup.BulkParticipantResult {
+	results := make(map[string]*domainGroup.BulkParticipantResult, len(numbers))
+	fail := func(number, reason string) {
+		results[number] = &domainGroup.BulkParticipantResult{Participant: number, Status: bulkParticipantFailed, Reason: reason}
+	}
+
+	var queries []string
+	for _, number := range numbers {
+		if _, err := utils.NormalizePhoneNumber(number); err != nil {
+			fail(number, err.Error())
+			continue
+		}
+		queries = append(queries, "+"+number)
+	}
+
+	// Resolve the numbers in one query; numbers missing from the answer are treated as not registered
+	jidToNumber := make(map[string]string)
+	registeredNumbers := make(map[string]bool)
+	var participantJIDs []types.JID
+	if len(queries) > 0 {
+		registered, err := whatsapp.GetClient().IsOnWhatsApp(queries)
+		if err != nil {
+			for _, query := range queries {
+				fail(strings.TrimPrefix(query, "+"), fmt.Sprintf("failed to check WhatsApp registration: %v", err))
+			}
+			queries = nil
+		}
+		for _, info := range registered {
+			number := strings.TrimPrefix(info.Query, "+")
+			if info.IsIn {
+				jidToNumber[info.JID.User] = number
+				registeredNumbers[number] = true
+				participantJIDs = append(participantJIDs, info.JID)
+			}
+		}
+	}
+	for _, query := range queries {
+		number := strings.TrimPrefix(query, "+")
+		if !registeredNumbers[number] {
+			fail(number, "not registered on WhatsApp")
+		}
+	}
+
+	if len(participantJIDs) > 0 {
+		participants, err := whatsapp.GetClient().UpdateGroupParticipants(groupInfo.JID, participantJIDs, whatsmeow.ParticipantChangeAdd)
+		if err != nil {
+			for _, jid := range participantJIDs {
+				fail(jidToNumber[jid.User], fmt.Sprintf("failed to add participant: %v", err))
+			}
+		}
+
+		for _, participant := range participants {
+			number, ok := jidToNumber[participant.PhoneNumber.User]
+			if !ok {
+				number = jidToNumber[participant.JID.User]
+			}
+
+			switch {
+			case participant.Error == 0:
+				results[number] = &domainGroup.BulkParticipantResult{Participant: number, Status: bulkParticipantAdded}
+			case participant.Error == 403 && participant.AddRequest != nil:
+				recipient := participant.JID
+				if !participant.PhoneNumber.IsEmpty() {
+					recipient = participant.PhoneNumber
+				}
+				if err := service.sendParticipantInvite(ctx, groupInfo, recipient, participant.AddRequest, invite); err != nil {
+					fail(number, fmt.Sprintf("cannot be added and the invite could not be sent: %v", err))
+				} else {
+					results[number] = &domainGroup.BulkParticipantResult{
+						Participant: number,
+						Status:      bulkParticipantInvited,
+						Reason:      "privacy settings prevent adding, invite sent",
+					}
+				}
+			case participant.Error == 409:
+				fail(number, "already a participant")
+			default:
+				fail(number, fmt.Sprintf("failed to add participant (error %d)", participant.Error))
+			}
+		}
+	}
+
+	// Keep the order of the input in the report
+	ordered := make([]domainGroup.BulkParticipantResult, 0, len(numbers))
+	for _, number := range numbers {
+		if result, ok := results[number]; ok {
+			ordered = append(ordered, *result)
+		} else {
+			ordered = append(ordered, domainGroup.BulkParticipantResult{Participant: number, Status: bulkParticipantFailed, Reason: "no result returned by WhatsApp"})
+		}
+	}
+
+	return ordered
+}
+
+// participantInvite holds what every invite card of a bulk add shares
+type participantInvite struct {
+	caption   string
+	thumbnail []byte
+}
+
+// sendParticipantInvite sends the invite card a participant needs to join when they cannot be added directly
+func (service serviceGroup) sendParticipantInvite(ctx context.Context, groupInfo *types.GroupInfo, recipient types.JID, addRequest *types.GroupParticipantAddRequest, invite participantInvite) error {
+	msg := &waE2E.Message{
+		GroupInviteMessage: &waE2E.GroupInviteMessage{
+			GroupJID:         proto.String(groupInfo.JID.String()),
+			InviteCode:       proto.String(addRequest.Code),
+			InviteExpiration: proto.Int64(addRequest.Expiration.Unix()),
+			GroupName:        proto.String(groupInfo.Name),
+			JPEGThumbnail:    invite.thumbnail,
+			Caption:          proto.String(invite.caption),
+		},
+	}
+
+	ts, err := whatsapp.GetClient().SendMessage(ctx, recipient, msg)
+	if err != nil {
+		return err
+	}
+
+	senderJID := ""
+	if whatsapp.GetClient().Store.ID != nil {
+		senderJID = whatsapp.GetClient().Store.ID.String()
+	}
+	if err := service.chatStorageRepo.StoreSentMessageWithContext(ctx, ts.ID, senderJID, recipient.String(), "👥 "+groupInfo.Name, "", ts.Timestamp); err != nil {
+		logrus.Warnf("Failed to store sent group invite: %v", err)
+	}
+
+	return nil
+}
+
 func (service serviceGroup) participantToJID(participants []string) ([]types.JID, error) {
 	var participantsJID []types.JID
 	for _, participant := range participants {
-		formattedParticipant := participant + config.WhatsappTypeUser
+		formattedParticipant := participant
+		if err := utils.SanitizePhone(&formattedParticipant); err != nil {
+			return nil, err
+		}
 
 		if !utils.IsOnWhatsapp(whatsapp.GetClient(), formattedParticipant) {
 			return nil, pkgError.ErrUserNotRegistered
@@ -310,6 +613,71 @@ func (service serviceGroup) SetGroupTopic(ctx context.Context, request domainGro
 	return whatsapp.GetClient().SetGroupTopic(groupJID, "", "", request.Topic)
 }
 
+func (service serviceGroup) SetGroupJoinApproval(ctx context.Context, request domainGroup.SetGroupJoinApprovalRequest) (err error) {
+	if err = validations.ValidateSetGroupJoinApproval(ctx, request); err != nil {
+		return err
+	}
+
+	groupJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
+	if err != nil {
+		return err
+	}
+
+	return whatsapp.GetClient().SetGroupJoinApprovalMode(groupJID, request.RequireApproval)
+}
+
+func (service serviceGroup) SetGroupMemberAddMode(ctx context.Context, request domainGroup.SetGroupMemberAddModeRequest) (err error) {
+	if err = validations.ValidateSetGroupMemberAddMode(ctx, request); err != nil {
+		return err
+	}
+
+	groupJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
+	if err != nil {
+		return err
+	}
+
+	return whatsapp.GetClient().SetGroupMemberAddMode(groupJID, types.GroupMemberAddMode(request.Mode))
+}
+
+// SetGroupEphemeral sets the disappearing messages timer of a group, the stored chat is updated by the group info event
+func (service serviceGroup) SetGroupEphemeral(ctx context.Context, request domainGroup.SetGroupEphemeralRequest) (err error) {
+	if err = validations.ValidateSetGroupEphemeral(ctx, request); err != nil {
+		return err
+	}
+
+	groupJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
+	if err != nil {
+		return err
+	}
+
+	timer, _ := whatsmeow.ParseDisappearingTimerString(request.Timer)
+	return whatsapp.GetClient().SetDisappearingTimer(groupJID, timer)
+}
+
+// RevokeGroupInviteLink invalidates the current invite link of a group and returns the new one
+func (service serviceGroup) RevokeGroupInviteLink(ctx context.Context, request domainGroup.RevokeGroupInviteLinkRequest) (response domainGroup.GetGroupInviteLinkResponse, err error) {
+	if err = validations.ValidateRevokeGroupInviteLink(ctx, request); err != nil {
+		return response, err
+	}
+
+	groupJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
+	if err != nil {
+		return response, err
+	}
+
+	inviteLink, err := whatsapp.GetClient().GetGroupInviteLink(groupJID, true)
+	if err != nil {
+		return response, err
+	}
+
+	response = domainGroup.GetGroupInviteLinkResponse{
+		InviteLink: inviteLink,
+		GroupID:    groupJID.String(),
+	}
+
+	return response, nil
+}
+
 // GroupInfo retrieves detailed information about a WhatsApp group
 func (service serviceGroup) GroupInfo(ctx context.Context, request domainGroup.GroupInfoRequest) (response domainGroup.GroupInfoResponse, err error) {
 	// Validate the incoming request
@@ -335,6 +703,11 @@ func (service serviceGroup) GroupInfo(ctx context.Context, request domainGroup.G
 	// Map the response
 	if groupInfo != nil {
 		response.Data = *groupInfo
+		response.IsParent = groupInfo.IsParent
+		response.IsDefaultSubGroup = groupInfo.IsDefaultSubGroup
+		if !groupInfo.LinkedParentJID.IsEmpty() {
+			response.LinkedParentJID = groupInfo.LinkedParentJID.String()
+		}
 	}
 
 	return response, nil
@@ -363,3 +736,346 @@ func (service serviceGroup) GetGroupInviteLink(ctx context.Context, request doma
 
 	return response, nil
 }
+
+func (service serviceGroup) LinkGroup(ctx context.Context, request domainGroup.LinkCommunityGroupRequest) (err error) {
+	if err = validations.ValidateLinkCommunityGroup(ctx, request); err != nil {
+		return err
+	}
+
+	communityJID, groupJID, err := service.communityAndGroupJID(request)
+	if err != nil {
+		return err
+	}
+
+	return whatsapp.GetClient().LinkGroup(communityJID, groupJID)
+}
+
+func (service serviceGroup) UnlinkGroup(ctx context.Context, request domainGroup.LinkCommunityGroupRequest) (err error) {
+	if err = validations.ValidateLinkCommunityGroup(ctx, request); err != nil {
+		return err
+	}
+
+	communityJID, groupJID, err := service.communityAndGroupJID(request)
+	if err != nil {
+		return err
+	}
+
+	return whatsapp.GetClient().UnlinkGroup(communityJID, groupJID)
+}
+
+func (service serviceGroup) GetSubGroups(ctx context.Context, request domainGroup.CommunityRequest) (result []domainGroup.CommunitySubGroup, err error) {
+	if err = validations.ValidateCommunity(ctx, request); err != nil {
+		return result, err
+	}
+
+	communityJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.CommunityID)
+	if err != nil {
+		return result, err
+	}
+
+	subGroups, err := whatsapp.GetClient().GetSubGroups(communityJID)
+	if err != nil {
+		return result, err
+	}
+
+	result = make([]domainGroup.CommunitySubGroup, 0, len(subGroups))
+	for _, subGroup := range subGroups {
+		result = append(result, domainGroup.CommunitySubGroup{
+			JID:               subGroup.JID.String(),
+			Name:              subGroup.Name,
+			IsDefaultSubGroup: subGroup.IsDefaultSubGroup,
+		})
+	}
+
+	return result, nil
+}
+
+func (service serviceGroup) GetCommunityParticipants(ctx context.Context, request domainGroup.CommunityRequest) (response domainGroup.CommunityParticipantsResponse, err error) {
+	if err = validations.ValidateCommunity(ctx, request); err != nil {
+		return response, err
+	}
+
+	communityJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.CommunityID)
+	if err != nil {
+		return response, err
+	}
+
+	participants, err := whatsapp.GetClient().GetLinkedGroupsParticipants(communityJID)
+	if err != nil {
+		return response, err
+	}
+
+	response.CommunityID = communityJID.String()
+	response.Participants = make([]string, 0, len(participants))
+	for _, participant := range participants {
+		response.Participants = append(response.Participants, participant.String())
+	}
+
+	return response, nil
+}
+
+// SendCommunityAnnouncement sends a text message to the announcement group of a community
+func (service serviceGroup) SendCommunityAnnouncement(ctx context.Context, request domainGroup.CommunityAnnouncementRequest) (response domainGroup.CommunityAnnouncementResponse, err error) {
+	if err = validations.ValidateCommunityAnnouncement(ctx, request); err != nil {
+		return response, err
+	}
+
+	subGroups, err := service.GetSubGroups(ctx, domainGroup.CommunityRequest{CommunityID: request.CommunityID})
+	if err != nil {
+		return response, err
+	}
+
+	for _, subGroup := range subGroups {
+		if !subGroup.IsDefaultSubGroup {
+			continue
+		}
+
+		sent, err := service.sendService.SendText(ctx, domainSend.MessageRequest{
+			BaseRequest: domainSend.BaseRequest{Phone: subGroup.JID},
+			Message:     request.Message,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.GroupID = subGroup.JID
+		response.MessageID = sent.MessageID
+		response.Status = sent.Status
+		return response, nil
+	}
+
+	return response, pkgError.ValidationError(fmt.Sprintf("community %s has no announcement group", request.CommunityID))
+}
+
+func (service serviceGroup) communityAndGroupJID(request domainGroup.LinkCommunityGroupRequest) (communityJID types.JID, groupJID types.JID, err error) {
+	communityJID, err = utils.ValidateJidWithLogin(whatsapp.GetClient(), request.CommunityID)
+	if err != nil {
+		return communityJID, groupJID, err
+	}
+
+	groupJID, err = utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
+	return communityJID, groupJID, err
+}
+
+func (service serviceGroup) GetGroupMembership(ctx context.Context, request domainGroup.GroupMembershipRequest) (response domainGroup.GroupMembershipResponse, err error) {
+	if err = validations.ValidateGroupMembership(ctx, request); err != nil {
+		return response, err
+	}
+
+	groupJID, err := utils.ParseJID(request.GroupID)
+	if err != nil {
+		return response, err
+	}
+
+	at := time.Now()
+	if request.At != "" {
+		at, _ = time.Parse(time.RFC3339, request.At)
+	}
+
+	events, err := service.chatStorageRepo.GetGroupMembershipEvents(groupJID.String(), time.Time{})
+	if err != nil {
+		return response, err
+	}
+
+	replay := newGroupMembershipReplay()
+	replay.applyUntil(events, 0, at)
+
+	response.GroupID = groupJID.String()
+	response.At = at.Format(time.RFC3339)
+	if len(events) > 0 {
+		response.TrackedSince = events[0].Timestamp.Format(time.RFC3339)
+	}
+	response.Members = replay.list()
+	response.Total = len(response.Members)
+
+	return response, nil
+}
+
+func (service serviceGroup) GetGroupGrowth(ctx context.Context, request domainGroup.GroupMembershipPeriodRequest) (response domainGroup.GroupGrowthResponse, err error) {
+	if err = validations.ValidateGroupGrowth(ctx, request); err != nil {
+		return response, err
+	}
+
+	groupJID, err := utils.ParseJID(request.GroupID)
+	if err != nil {
+		return response, err
+	}
+
+	from, to := groupMembershipPeriod(request)
+	events, err := service.chatStorageRepo.GetGroupMembershipEvents(groupJID.String(), to)
+	if err != nil {
+		return response, err
+	}
+
+	replay := newGroupMembershipReplay()
+	next := replay.applyUntil(events, 0, from)
+
+	response.GroupID = groupJID.String()
+	response.From = from.Format(time.RFC3339)
+	response.To = to.Format(time.RFC3339)
+	response.MembersAtStart = len(replay.members)
+	response.Daily = []domainGroup.GroupGrowthPoint{}
+
+	// Walk the period one UTC day at a time, closing each bucket at the end of the day or of the period
+	for dayStart := from; dayStart.Before(to); {
+		dayEnd := time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day()+1, 0, 0, 0, 0, time.UTC)
+		if dayEnd.After(to) {
+			dayEnd = to
+		}
+
+		joinedBefore, leftBefore := replay.joined, replay.left
+		next = replay.applyUntil(events, next, dayEnd)
+
+		response.Daily = append(response.Daily, domainGroup.GroupGrowthPoint{
+			Date:    dayStart.Format("2006-01-02"),
+			Joined:  replay.joined - joinedBefore,
+			Left:    replay.left - leftBefore,
+			Members: len(replay.members),
+		})
+		dayStart = dayEnd
+	}
+
+	response.MembersAtEnd = len(replay.members)
+	response.Joined = replay.joined
+	response.Left = replay.left
+	response.NetGrowth = response.MembersAtEnd - response.MembersAtStart
+
+	return response, nil
+}
+
+func (service serviceGroup) GetGroupChurn(ctx context.Context, request domainGroup.GroupMembershipPeriodRequest) (response domainGroup.GroupChurnResponse, err error) {
+	if err = validations.ValidateGroupChurn(ctx, request); err != nil {
+		return response, err
+	}
+
+	var groups []string
+	if request.GroupID != "" {
+		groupJID, err := utils.ParseJID(request.GroupID)
+		if err != nil {
+			return response, err
+		}
+		groups = []string{groupJID.String()}
+	} else if groups, err = service.chatStorageRepo.GetGroupMembershipGroups(); err != nil {
+		return response, err
+	}
+
+	from, to := groupMembershipPeriod(request)
+	response.From = from.Format(time.RFC3339)
+	response.To = to.Format(time.RFC3339)
+	response.Data = []domainGroup.GroupChurn{}
+
+	for _, group := range groups {
+		events, err := service.chatStorageRepo.GetGroupMembershipEvents(group, to)
+		if err != nil {
+			return response, err
+		}
+
+		replay := newGroupMembershipReplay()
+		next := replay.applyUntil(events, 0, from)
+		churn := domainGroup.GroupChurn{
+			GroupID:        group,
+			MembersAtStart: len(replay.members),
+		}
+
+		replay.applyUntil(events, next, to)
+		churn.Joined = replay.joined
+		churn.Left = replay.left
+		if churn.MembersAtStart > 0 {
+			churn.ChurnRate = float64(churn.Left) / float64(churn.MembersAtStart)
+		}
+
+		response.Data = append(response.Data, churn)
+	}
+
+	return response, nil
+}
+
+// groupMembershipPeriod resolves the requested period in UTC, defaulting to the last 30 days
+func groupMembershipPeriod(request domainGroup.GroupMembershipPeriodRequest) (from time.Time, to time.Time) {
+	to = time.Now()
+	if request.To != "" {
+		to, _ = time.Parse(time.RFC3339, request.To)
+	}
+
+	from = to.Add(-defaultGroupMembershipPeriod)
+	if request.From != "" {
+		from, _ = time.Parse(time.RFC3339, request.From)
+	}
+
+	return from.UTC(), to.UTC()
+}
+
+// groupMembershipReplay rebuilds the participants of a group by applying its membership history in order
+type groupMembershipReplay struct {
+	members    map[string]*domainGroup.GroupMember
+	previous   map[string]*domainGroup.GroupMember // members before the snapshot being applied
+	snapshotAt time.Time
+	joined     int
+	left       int
+}
+
+func newGroupMembershipReplay() *groupMembershipReplay {
+	return &groupMembershipReplay{members: make(map[string]*domainGroup.GroupMember)}
+}
+
+// applyUntil applies events starting at index start up to and including until, returning the next index
+func (replay *groupMembershipReplay) applyUntil(events []*domainChatStorage.GroupMembershipEvent, start int, until time.Time) int {
+	for start < len(events) && !events[start].Timestamp.After(until) {
+		replay.apply(events[start])
+		start++
+	}
+	return start
+}
+
+func (replay *groupMembershipReplay) apply(event *domainChatStorage.GroupMembershipEvent) {
+	jid := event.ParticipantJID
+	timestamp := event.Timestamp.Format(time.RFC3339)
+
+	switch event.Action {
+	case domainChatStorage.GroupMembershipSnapshot:
+		// A new snapshot is authoritative: participants missing from it are no longer members
+		if !event.Timestamp.Equal(replay.snapshotAt) {
+			replay.previous = replay.members
+			replay.members = make(map[string]*domainGroup.GroupMember)
+			replay.snapshotAt = event.Timestamp
+		}
+
+		member, ok := replay.previous[jid]
+		if !ok {
+			member = &domainGroup.GroupMember{JID: jid, Since: timestamp, Source: event.Action}
+		}
+		member.IsAdmin = event.IsAdmin
+		replay.members[jid] = member
+	case domainChatStorage.GroupMembershipJoin, domainChatStorage.GroupMembershipAdd:
+		if _, ok := replay.members[jid]; !ok {
+			replay.joined++
+		}
+
+		member := &domainGroup.GroupMember{JID: jid, Since: timestamp, Source: event.Action}
+		if event.Action == domainChatStorage.GroupMembershipAdd {
+			member.AddedBy = event.ActorJID
+		}
+		replay.members[jid] = member
+	case domainChatStorage.GroupMembershipLeave, domainChatStorage.GroupMembershipRemove:
+		if _, ok := replay.members[jid]; ok {
+			delete(replay.members, jid)
+			replay.left++
+		}
+	case domainChatStorage.GroupMembershipPromote, domainChatStorage.GroupMembershipDemote:
+		if member, ok := replay.members[jid]; ok {
+			member.IsAdmin = event.Action == domainChatStorage.GroupMembershipPromote
+		}
+	}
+}
+
+// list returns the current members sorted by JID
+func (replay *groupMembershipReplay) list() []domainGroup.GroupMember {
+	members := make([]domainGroup.GroupMember, 0, len(replay.members))
+	for _, member := range replay.members {
+		members = append(members, *member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].JID < members[j].JID
+	})
+	return members
+}
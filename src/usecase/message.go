@@ -11,6 +11,7 @@ import (
 	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	domainMessage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/message"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
 	"github.com/sirupsen/logrus"
@@ -68,6 +69,7 @@ func (service serviceMessage) ReactMessage(ctx context.Context, request domainMe
 		return response, err
 	}
 
+	reactedAt := time.Now()
 	msg := &waE2E.Message{
 		ReactionMessage: &waE2E.ReactionMessage{
 			Key: &waCommon.MessageKey{
@@ -76,7 +78,7 @@ func (service serviceMessage) ReactMessage(ctx context.Context, request domainMe
 				RemoteJID: proto.String(dataWaRecipient.String()),
 			},
 			Text:              proto.String(request.Emoji),
-			SenderTimestampMS: proto.Int64(time.Now().UnixMilli()),
+			SenderTimestampMS: proto.Int64(reactedAt.UnixMilli()),
 		},
 	}
 	ts, err := whatsapp.GetClient().SendMessage(ctx, dataWaRecipient, msg)
@@ -84,7 +86,30 @@ func (service serviceMessage) ReactMessage(ctx context.Context, request domainMe
 		return response, err
 	}
 
+	// Our own reactions are not echoed back as events, so they are stored here. An empty emoji removes the reaction.
+	if ownJID := whatsapp.GetClient().Store.ID; ownJID != nil {
+		reactor := ownJID.ToNonAD().String()
+		if request.Emoji == "" {
+			err = service.chatStorageRepo.DeleteMessageReaction(request.MessageID, dataWaRecipient.String(), reactor, reactedAt)
+		} else {
+			err = service.chatStorageRepo.StoreMessageReaction(&domainChatStorage.MessageReaction{
+				MessageID: request.MessageID,
+				ChatJID:   dataWaRecipient.String(),
+				Reactor:   reactor,
+				Emoji:     request.Emoji,
+				ReactedAt: reactedAt,
+			})
+		}
+		if err != nil {
+			logrus.Warnf("Failed to store reaction to message %s: %v", request.MessageID, err)
+		}
+	}
+
 	response.MessageID = ts.ID
+	if request.Emoji == "" {
+		response.Status = fmt.Sprintf("Reaction removed from %s (server timestamp: %s)", request.Phone, ts.Timestamp)
+		return response, nil
+	}
 	response.Status = fmt.Sprintf("Reaction sent to %s (server timestamp: %s)", request.Phone, ts.Timestamp)
 	return response, nil
 }
@@ -152,17 +177,141 @@ func (service serviceMessage) UpdateMessage(ctx context.Context, request domainM
 		return response, err
 	}
 
-	msg := &waE2E.Message{Conversation: proto.String(request.Message)}
+	// Media messages keep their media and only get a new caption
+	mediaType := ""
+	if stored, err := service.chatStorageRepo.GetMessageByID(request.MessageID); err != nil {
+		logrus.Warnf("Failed to look up message %s before editing: %v", request.MessageID, err)
+	} else if stored != nil {
+		mediaType = stored.MediaType
+	}
+
+	msg := buildEditedMessage(mediaType, request.Message)
 	ts, err := whatsapp.GetClient().SendMessage(context.Background(), dataWaRecipient, whatsapp.GetClient().BuildEdit(dataWaRecipient, request.MessageID, msg))
 	if err != nil {
 		return response, err
 	}
 
+	editor := ""
+	if whatsapp.GetClient().Store.ID != nil {
+		editor = whatsapp.GetClient().Store.ID.ToNonAD().String()
+	}
+	edit := &domainChatStorage.MessageEdit{
+		MessageID:  request.MessageID,
+		ChatJID:    dataWaRecipient.String(),
+		Editor:     editor,
+		NewContent: utils.ExtractMessageTextFromProto(msg),
+		EditedAt:   ts.Timestamp,
+	}
+	if err := service.chatStorageRepo.StoreMessageEdit(edit); err != nil {
+		logrus.Errorf("Failed to store edit of message %s: %v", request.MessageID, err)
+	}
+
 	response.MessageID = ts.ID
 	response.Status = fmt.Sprintf("Update message success %s (server timestamp: %s)", request.Phone, ts.Timestamp)
 	return response, nil
 }
 
+// messagePinDurations maps the supported pin durations to their length
+var messagePinDurations = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// defaultMessagePinDuration matches WhatsApp, which pins for 7 days unless another duration is picked
+const defaultMessagePinDuration = "7d"
+
+func (service serviceMessage) PinMessage(ctx context.Context, request domainMessage.PinMessageRequest) (response domainMessage.GenericResponse, err error) {
+	if err = validations.ValidatePinMessage(ctx, request); err != nil {
+		return response, err
+	}
+	if request.IsPinned && request.Duration == "" {
+		request.Duration = defaultMessagePinDuration
+	}
+
+	dataWaRecipient, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.Phone)
+	if err != nil {
+		return response, err
+	}
+
+	// Messages from others must be referenced with their sender, ours with an empty sender
+	sender := types.EmptyJID
+	if stored, err := service.chatStorageRepo.GetMessageByID(request.MessageID); err != nil {
+		logrus.Warnf("Failed to look up message %s before pinning: %v", request.MessageID, err)
+	} else if stored != nil && !stored.IsFromMe {
+		if sender, err = types.ParseJID(stored.Sender); err != nil {
+			sender = types.EmptyJID
+		}
+	}
+
+	pinType := waE2E.PinInChatMessage_UNPIN_FOR_ALL
+	if request.IsPinned {
+		pinType = waE2E.PinInChatMessage_PIN_FOR_ALL
+	}
+
+	msg := &waE2E.Message{
+		PinInChatMessage: &waE2E.PinInChatMessage{
+			Key:               whatsapp.GetClient().BuildMessageKey(dataWaRecipient, sender, request.MessageID),
+			Type:              pinType.Enum(),
+			SenderTimestampMS: proto.Int64(time.Now().UnixMilli()),
+		},
+	}
+	duration := messagePinDurations[request.Duration]
+	if request.IsPinned {
+		msg.MessageContextInfo = &waE2E.MessageContextInfo{
+			MessageAddOnDurationInSecs: proto.Uint32(uint32(duration.Seconds())),
+		}
+	}
+
+	ts, err := whatsapp.GetClient().SendMessage(ctx, dataWaRecipient, msg)
+	if err != nil {
+		return response, err
+	}
+
+	// Our own pins are not echoed back to this device, so track them here
+	if request.IsPinned {
+		pinnedBy := ""
+		if whatsapp.GetClient().Store.ID != nil {
+			pinnedBy = whatsapp.GetClient().Store.ID.ToNonAD().String()
+		}
+		err = service.chatStorageRepo.StorePinnedMessage(&domainChatStorage.PinnedMessage{
+			MessageID: request.MessageID,
+			ChatJID:   dataWaRecipient.String(),
+			PinnedBy:  pinnedBy,
+			PinnedAt:  ts.Timestamp,
+			ExpiresAt: ts.Timestamp.Add(duration),
+		})
+	} else {
+		err = service.chatStorageRepo.DeletePinnedMessage(request.MessageID, dataWaRecipient.String())
+	}
+	if err != nil {
+		logrus.Errorf("Failed to store pin state of message %s: %v", request.MessageID, err)
+	}
+
+	response.MessageID = ts.ID
+	if request.IsPinned {
+		response.Status = fmt.Sprintf("Message pinned in %s for %s (server timestamp: %s)", request.Phone, request.Duration, ts.Timestamp)
+	} else {
+		response.Status = fmt.Sprintf("Message unpinned in %s (server timestamp: %s)", request.Phone, ts.Timestamp)
+	}
+	return response, nil
+}
+
+// buildEditedMessage returns the edit content for a message of the given media type. The stored edit is
+// extracted from it the same way as edits made on other devices, see handleMessageEdit.
+func buildEditedMessage(mediaType, text string) *waE2E.Message {
+	switch mediaType {
+	case "image":
+		return &waE2E.Message{ImageMessage: &waE2E.ImageMessage{Caption: proto.String(text)}}
+	case "video":
+		return &waE2E.Message{VideoMessage: &waE2E.VideoMessage{Caption: proto.String(text)}}
+	case "document":
+		return &waE2E.Message{DocumentMessage: &waE2E.DocumentMessage{Caption: proto.String(text)}}
+	default:
+		return &waE2E.Message{Conversation: proto.String(text)}
+	}
+}
+
 // StarMessage implements message.IMessageService.
 func (service serviceMessage) StarMessage(ctx context.Context, request domainMessage.StarRequest) (err error) {
 	if err = validations.ValidateStarMessage(ctx, request); err != nil {
@@ -309,3 +458,124 @@ func (service serviceMessage) DownloadMedia(ctx context.Context, request domainM
 
 	return response, nil
 }
+
+func (service serviceMessage) GetPollResults(ctx context.Context, request domainMessage.PollResultsRequest) (response domainMessage.PollResultsResponse, err error) {
+	if err = validations.ValidatePollResults(ctx, request); err != nil {
+		return response, err
+	}
+
+	poll, err := service.chatStorageRepo.GetPoll(request.MessageID)
+	if err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to get poll: %v", err))
+	}
+	if poll == nil {
+		return response, pkgError.ValidationError(fmt.Sprintf("poll with message ID %s not found", request.MessageID))
+	}
+
+	votes, err := service.chatStorageRepo.GetPollVotes(poll.MessageID)
+	if err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to get poll votes: %v", err))
+	}
+
+	// Tally votes in the original option order
+	optionIndex := make(map[string]int, len(poll.Options))
+	response.Options = make([]domainMessage.PollOptionResult, len(poll.Options))
+	for i, option := range poll.Options {
+		optionIndex[option] = i
+		response.Options[i] = domainMessage.PollOptionResult{Option: option, Voters: []string{}}
+	}
+
+	for _, vote := range votes {
+		// An empty selection means the voter retracted their vote
+		if len(vote.SelectedOptions) == 0 {
+			continue
+		}
+		response.TotalVoters++
+		for _, option := range vote.SelectedOptions {
+			if i, ok := optionIndex[option]; ok {
+				response.Options[i].Votes++
+				response.Options[i].Voters = append(response.Options[i].Voters, vote.Voter)
+			}
+		}
+	}
+
+	response.MessageID = poll.MessageID
+	response.ChatJID = poll.ChatJID
+	response.Question = poll.Question
+	response.MaxAnswer = poll.MaxAnswer
+	return response, nil
+}
+
+func (service serviceMessage) GetMessageReceipts(ctx context.Context, request domainMessage.MessageReceiptsRequest) (response domainMessage.MessageReceiptsResponse, err error) {
+	if err = validations.ValidateMessageReceipts(ctx, request); err != nil {
+		return response, err
+	}
+
+	message, err := service.chatStorageRepo.GetMessageByID(request.MessageID)
+	if err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to get message: %v", err))
+	}
+	if message == nil {
+		return response, pkgError.ValidationError(fmt.Sprintf("message with ID %s not found", request.MessageID))
+	}
+	if !message.IsFromMe {
+		return response, pkgError.ValidationError("receipts are only tracked for messages sent by this account")
+	}
+
+	receipts, err := service.chatStorageRepo.GetMessageReceipts(message.ChatJID, message.ID)
+	if err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to get message receipts: %v", err))
+	}
+
+	response.Receipts = make([]domainMessage.MessageReceipt, 0, len(receipts))
+	for _, receipt := range receipts {
+		response.Receipts = append(response.Receipts, domainMessage.MessageReceipt{
+			Participant: receipt.Participant,
+			Status:      utils.ReceiptStatus(receipt),
+			DeliveredAt: formatOptionalTime(receipt.DeliveredAt),
+			ReadAt:      formatOptionalTime(receipt.ReadAt),
+			PlayedAt:    formatOptionalTime(receipt.PlayedAt),
+		})
+	}
+
+	response.MessageID = message.ID
+	response.ChatJID = message.ChatJID
+	response.Status = utils.MessageStatus(receipts, messageRecipients(message.ChatJID))
+	return response, nil
+}
+
+// messageRecipients returns how many people are expected to send receipts for a message sent to a chat, or 0 when
+// the participants of a group cannot be fetched
+func messageRecipients(chatJID string) int {
+	jid, err := types.ParseJID(chatJID)
+	if err != nil || jid.Server != types.GroupServer {
+		return 1
+	}
+
+	client := whatsapp.GetClient()
+	if client == nil {
+		return 0
+	}
+	groupInfo, err := client.GetGroupInfo(jid)
+	if err != nil {
+		logrus.Warnf("Failed to get participants of %s for message status: %v", chatJID, err)
+		return 0
+	}
+
+	recipients := 0
+	for _, participant := range groupInfo.Participants {
+		isMe := (client.Store.ID != nil && participant.JID.User == client.Store.ID.User) ||
+			(!client.Store.LID.IsEmpty() && participant.JID.User == client.Store.LID.User)
+		if !isMe {
+			recipients++
+		}
+	}
+	return recipients
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -2,17 +2,45 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
+	"mime/multipart"
+	"strings"
+	"time"
 
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	domainNewsletter "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/newsletter"
+	domainSend "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/send"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
+	"github.com/sirupsen/logrus"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
 )
 
-type serviceNewsletter struct{}
+const (
+	// defaultNewsletterMessagesLimit is used when the caller does not ask for a page size
+	defaultNewsletterMessagesLimit = 50
+	// newsletterInviteBaseURL is the prefix of public channel invite links
+	newsletterInviteBaseURL = "https://whatsapp.com/channel/"
+	// newsletterTOSNoticeID and newsletterTOSStage must be accepted once before an account can create channels
+	newsletterTOSNoticeID = "20601218"
+	newsletterTOSStage    = "5"
+	// mutationUpdateNewsletter is the mex query used by WhatsApp Web to edit channel metadata
+	mutationUpdateNewsletter = "7150902998257522"
+)
+
+type serviceNewsletter struct {
+	sendService     domainSend.ISendUsecase
+	chatStorageRepo domainChatStorage.IChatStorageRepository
+}
 
-func NewNewsletterService() domainNewsletter.INewsletterUsecase {
-	return &serviceNewsletter{}
+func NewNewsletterService(sendService domainSend.ISendUsecase, chatStorageRepo domainChatStorage.IChatStorageRepository) domainNewsletter.INewsletterUsecase {
+	return &serviceNewsletter{
+		sendService:     sendService,
+		chatStorageRepo: chatStorageRepo,
+	}
 }
 
 func (service serviceNewsletter) Unfollow(ctx context.Context, request domainNewsletter.UnfollowRequest) (err error) {
@@ -27,3 +55,243 @@ func (service serviceNewsletter) Unfollow(ctx context.Context, request domainNew
 
 	return whatsapp.GetClient().UnfollowNewsletter(JID)
 }
+
+func (service serviceNewsletter) Create(ctx context.Context, request domainNewsletter.CreateNewsletterRequest) (response domainNewsletter.NewsletterResponse, err error) {
+	if err = validations.ValidateCreateNewsletter(ctx, request); err != nil {
+		return response, err
+	}
+	utils.MustLogin(whatsapp.GetClient())
+
+	picture, err := service.processPicture(request.Picture)
+	if err != nil {
+		return response, err
+	}
+
+	if err = whatsapp.GetClient().AcceptTOSNotice(newsletterTOSNoticeID, newsletterTOSStage); err != nil {
+		logrus.Warnf("Failed to accept newsletter terms notice: %v", err)
+	}
+
+	metadata, err := whatsapp.GetClient().CreateNewsletter(whatsmeow.CreateNewsletterParams{
+		Name:        request.Name,
+		Description: request.Description,
+		Picture:     picture,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return service.buildResponse(metadata), nil
+}
+
+func (service serviceNewsletter) Update(ctx context.Context, request domainNewsletter.UpdateNewsletterRequest) (response domainNewsletter.NewsletterResponse, err error) {
+	if err = validations.ValidateUpdateNewsletter(ctx, request); err != nil {
+		return response, err
+	}
+
+	JID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), service.normalizeNewsletterID(request.NewsletterID))
+	if err != nil {
+		return response, err
+	}
+
+	updates := map[string]any{"settings": nil}
+	if request.Name != nil {
+		updates["name"] = *request.Name
+	}
+	if request.Description != nil {
+		updates["description"] = *request.Description
+	}
+	if request.Picture != nil {
+		picture, err := service.processPicture(request.Picture)
+		if err != nil {
+			return response, err
+		}
+		updates["picture"] = picture
+	}
+
+	// whatsmeow has no helper for editing channel metadata, so the mutation is sent directly
+	raw, err := whatsapp.GetClient().DangerousInternals().SendMexIQ(ctx, mutationUpdateNewsletter, map[string]any{
+		"newsletter_id": JID.String(),
+		"updates":       updates,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	var result struct {
+		Newsletter *types.NewsletterMetadata `json:"xwa2_newsletter_update"`
+	}
+	if err = json.Unmarshal(raw, &result); err != nil || result.Newsletter == nil {
+		// The mutation response may omit fields, fetch the full metadata instead
+		result.Newsletter, err = whatsapp.GetClient().GetNewsletterInfo(JID)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	return service.buildResponse(result.Newsletter), nil
+}
+
+func (service serviceNewsletter) Follow(ctx context.Context, request domainNewsletter.FollowNewsletterRequest) (response domainNewsletter.NewsletterResponse, err error) {
+	if err = validations.ValidateFollowNewsletter(ctx, request); err != nil {
+		return response, err
+	}
+	utils.MustLogin(whatsapp.GetClient())
+
+	metadata, err := whatsapp.GetClient().GetNewsletterInfoWithInvite(utils.ParseNewsletterInviteCode(request.Link))
+	if err != nil {
+		return response, err
+	}
+
+	if err = whatsapp.GetClient().FollowNewsletter(metadata.ID); err != nil {
+		return response, err
+	}
+
+	return service.buildResponse(metadata), nil
+}
+
+func (service serviceNewsletter) Mute(ctx context.Context, request domainNewsletter.MuteNewsletterRequest) (err error) {
+	if err = validations.ValidateMuteNewsletter(ctx, request); err != nil {
+		return err
+	}
+
+	JID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), service.normalizeNewsletterID(request.NewsletterID))
+	if err != nil {
+		return err
+	}
+
+	return whatsapp.GetClient().NewsletterToggleMute(JID, request.Mute)
+}
+
+func (service serviceNewsletter) GetInfo(ctx context.Context, request domainNewsletter.GetNewsletterInfoRequest) (response domainNewsletter.NewsletterResponse, err error) {
+	if err = validations.ValidateGetNewsletterInfo(ctx, request); err != nil {
+		return response, err
+	}
+	utils.MustLogin(whatsapp.GetClient())
+
+	metadata, err := whatsapp.GetClient().GetNewsletterInfoWithInvite(utils.ParseNewsletterInviteCode(request.Link))
+	if err != nil {
+		return response, err
+	}
+
+	return service.buildResponse(metadata), nil
+}
+
+func (service serviceNewsletter) Post(ctx context.Context, request domainNewsletter.PostNewsletterRequest) (response domainNewsletter.PostNewsletterResponse, err error) {
+	if err = validations.ValidatePostNewsletter(ctx, request); err != nil {
+		return response, err
+	}
+
+	base := domainSend.BaseRequest{Phone: service.normalizeNewsletterID(request.NewsletterID)}
+
+	var sent domainSend.GenericResponse
+	switch {
+	case request.Image != nil || (request.ImageURL != nil && *request.ImageURL != ""):
+		sent, err = service.sendService.SendImage(ctx, domainSend.ImageRequest{
+			BaseRequest: base,
+			Caption:     request.Message,
+			Image:       request.Image,
+			ImageURL:    request.ImageURL,
+		})
+	case request.Video != nil || (request.VideoURL != nil && *request.VideoURL != ""):
+		sent, err = service.sendService.SendVideo(ctx, domainSend.VideoRequest{
+			BaseRequest: base,
+			Caption:     request.Message,
+			Video:       request.Video,
+			VideoURL:    request.VideoURL,
+		})
+	default:
+		sent, err = service.sendService.SendText(ctx, domainSend.MessageRequest{
+			BaseRequest: base,
+			Message:     request.Message,
+		})
+	}
+	if err != nil {
+		return response, err
+	}
+
+	response.MessageID = sent.MessageID
+	response.Status = sent.Status
+	return response, nil
+}
+
+func (service serviceNewsletter) GetMessages(ctx context.Context, request domainNewsletter.GetNewsletterMessagesRequest) (response domainNewsletter.GetNewsletterMessagesResponse, err error) {
+	if err = validations.ValidateGetNewsletterMessages(ctx, request); err != nil {
+		return response, err
+	}
+	if request.Limit == 0 {
+		request.Limit = defaultNewsletterMessagesLimit
+	}
+
+	JID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), service.normalizeNewsletterID(request.NewsletterID))
+	if err != nil {
+		return response, err
+	}
+
+	// Pull the requested page from the server so history is archived on demand, stored posts are served if that fails
+	messages, err := whatsapp.GetClient().GetNewsletterMessages(JID, &whatsmeow.GetNewsletterMessagesParams{
+		Count:  request.Limit,
+		Before: types.MessageServerID(request.Before),
+	})
+	if err != nil {
+		logrus.Warnf("Failed to fetch messages of newsletter %s, serving stored posts: %v", JID, err)
+	} else {
+		whatsapp.StoreNewsletterMessages(service.chatStorageRepo, JID, messages)
+	}
+
+	stored, err := service.chatStorageRepo.GetNewsletterMessages(JID.String(), request.Limit, request.Before)
+	if err != nil {
+		return response, err
+	}
+
+	response.NewsletterID = JID.String()
+	response.Data = make([]domainNewsletter.NewsletterMessageInfo, 0, len(stored))
+	for _, message := range stored {
+		response.Data = append(response.Data, domainNewsletter.NewsletterMessageInfo{
+			ServerID:       message.ServerID,
+			MessageID:      message.MessageID,
+			Type:           message.Type,
+			Content:        message.Content,
+			MediaType:      message.MediaType,
+			Timestamp:      message.Timestamp.Format(time.RFC3339),
+			ViewsCount:     message.ViewsCount,
+			ReactionCounts: message.ReactionCounts,
+			UpdatedAt:      message.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return response, nil
+}
+
+// normalizeNewsletterID accepts a bare channel ID and appends the newsletter server
+func (service serviceNewsletter) normalizeNewsletterID(newsletterID string) string {
+	newsletterID = strings.TrimSpace(newsletterID)
+	if !strings.Contains(newsletterID, "@") {
+		newsletterID += "@" + types.NewsletterServer
+	}
+	return newsletterID
+}
+
+// processPicture resizes an uploaded picture the same way group photos are prepared
+func (service serviceNewsletter) processPicture(picture *multipart.FileHeader) ([]byte, error) {
+	if picture == nil {
+		return nil, nil
+	}
+	if err := utils.ValidateGroupPhotoFormat(picture); err != nil {
+		return nil, pkgError.ValidationError(err.Error())
+	}
+
+	processed, err := utils.ProcessGroupPhoto(picture)
+	if err != nil {
+		logrus.Printf("Failed to process newsletter picture: %v", err)
+		return nil, err
+	}
+	return processed.Bytes(), nil
+}
+
+func (service serviceNewsletter) buildResponse(metadata *types.NewsletterMetadata) domainNewsletter.NewsletterResponse {
+	response := domainNewsletter.NewsletterResponse{Newsletter: metadata}
+	if metadata != nil && metadata.ThreadMeta.InviteCode != "" {
+		response.InviteLink = newsletterInviteBaseURL + metadata.ThreadMeta.InviteCode
+	}
+	return response
+}
@@ -43,25 +43,35 @@ func NewSendService(appService app.IAppUsecase, chatStorageRepo domainChatStorag
 }
 
 // wrapSendMessage wraps the message sending process with message ID saving
-func (service serviceSend) wrapSendMessage(ctx context.Context, recipient types.JID, msg *waE2E.Message, content string) (whatsmeow.SendResponse, error) {
-	ts, err := whatsapp.GetClient().SendMessage(ctx, recipient, msg)
+// Media sends pass the upload handle as extra, it is required when posting media to newsletters
+func (service serviceSend) wrapSendMessage(ctx context.Context, recipient types.JID, msg *waE2E.Message, content string, extra ...whatsmeow.SendRequestExtra) (whatsmeow.SendResponse, error) {
+	ts, err := whatsapp.GetClient().SendMessage(ctx, recipient, msg, extra...)
 	if err != nil {
 		return whatsmeow.SendResponse{}, err
 	}
 
+	// Newsletter posts are keyed by server ID and kept in their own table
+	if recipient.Server == types.NewsletterServer {
+		whatsapp.StoreSentNewsletterMessage(service.chatStorageRepo, recipient, ts, msg)
+		return ts, nil
+	}
+
 	// Store the sent message using chatstorage
 	senderJID := ""
 	if whatsapp.GetClient().Store.ID != nil {
 		senderJID = whatsapp.GetClient().Store.ID.String()
 	}
 
+	// Keep the media type so captions of sent media can be edited later
+	mediaType, _, _, _, _, _, _ := utils.ExtractMediaInfo(msg)
+
 	// Store message asynchronously with timeout
 	// Use a goroutine to avoid blocking the send operation
 	go func() {
 		storeCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 
-		if err := service.chatStorageRepo.StoreSentMessageWithContext(storeCtx, ts.ID, senderJID, recipient.String(), content, ts.Timestamp); err != nil {
+		if err := service.chatStorageRepo.StoreSentMessageWithContext(storeCtx, ts.ID, senderJID, recipient.String(), content, mediaType, ts.Timestamp); err != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
 				logrus.Warn("Timeout storing sent message")
 			} else {
@@ -73,11 +83,115 @@ func (service serviceSend) wrapSendMessage(ctx context.Context, recipient types.
 	return ts, nil
 }
 
+// simulateTyping marks the chat read, then shows typing (or recording for audio) for about as long as a person
+// would need for the message before it is sent. The request setting overrides config.WhatsappSimulateTyping.
+func (service serviceSend) simulateTyping(ctx context.Context, recipient types.JID, base domainSend.BaseRequest, msg *waE2E.Message, content string) error {
+	enabled := config.WhatsappSimulateTyping
+	if base.SimulateTyping != nil {
+		enabled = *base.SimulateTyping
+	}
+	// Channels have no chat state
+	if !enabled || recipient.Server == types.NewsletterServer {
+		return nil
+	}
+
+	service.markRecentMessagesRead(recipient)
+
+	media := types.ChatPresenceMediaText
+	duration := utils.TypingDuration(content)
+	if audio := msg.GetAudioMessage(); audio != nil {
+		media = types.ChatPresenceMediaAudio
+		duration = utils.RecordingDuration(audio.GetFileLength())
+	}
+
+	client := whatsapp.GetClient()
+	if err := client.SendChatPresence(recipient, types.ChatPresenceComposing, media); err != nil {
+		logrus.Warnf("Failed to send typing to %s: %v", recipient, err)
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		if err := client.SendChatPresence(recipient, types.ChatPresencePaused, media); err != nil {
+			logrus.Warnf("Failed to stop typing to %s: %v", recipient, err)
+		}
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	if err := client.SendChatPresence(recipient, types.ChatPresencePaused, media); err != nil {
+		logrus.Warnf("Failed to stop typing to %s: %v", recipient, err)
+	}
+	return nil
+}
+
+// markRecentMessagesRead sends read receipts for the latest stored messages of the chat that arrived after the
+// last ones marked as read, grouped by sender because group receipts are addressed per participant
+func (service serviceSend) markRecentMessagesRead(chat types.JID) {
+	lastRead, err := service.chatStorageRepo.GetChatLastReadTime(chat.String())
+	if err != nil {
+		logrus.Warnf("Failed to get the read watermark of %s: %v", chat, err)
+		return
+	}
+
+	isFromMe := false
+	filter := &domainChatStorage.MessageFilter{
+		ChatJID:  chat.String(),
+		Limit:    20,
+		IsFromMe: &isFromMe,
+	}
+	if !lastRead.IsZero() {
+		filter.StartTime = &lastRead
+	}
+	messages, err := service.chatStorageRepo.GetMessages(filter)
+	if err != nil {
+		logrus.Warnf("Failed to get messages to mark %s as read: %v", chat, err)
+		return
+	}
+
+	newest := lastRead
+	bySender := make(map[types.JID][]types.MessageID)
+	for _, message := range messages {
+		if message.ID == "" || !message.Timestamp.After(lastRead) {
+			continue
+		}
+		if message.Timestamp.After(newest) {
+			newest = message.Timestamp
+		}
+		sender := chat
+		if chat.Server == types.GroupServer {
+			if sender, err = types.ParseJID(message.Sender); err != nil {
+				continue
+			}
+			sender = sender.ToNonAD()
+		}
+		bySender[sender] = append(bySender[sender], types.MessageID(message.ID))
+	}
+
+	for sender, ids := range bySender {
+		if err := whatsapp.GetClient().MarkRead(ids, time.Now(), chat, sender); err != nil {
+			logrus.Warnf("Failed to mark messages in %s as read: %v", chat, err)
+			return
+		}
+	}
+
+	if newest.After(lastRead) {
+		if err := service.chatStorageRepo.UpdateChatLastReadTime(chat.String(), newest); err != nil {
+			logrus.Warnf("Failed to store the read watermark of %s: %v", chat, err)
+		}
+	}
+}
+
 func (service serviceSend) SendText(ctx context.Context, request domainSend.MessageRequest) (response domainSend.GenericResponse, err error) {
 	err = validations.ValidateSendMessage(ctx, request)
 	if err != nil {
 		return response, err
 	}
+	request.Message = utils.FormatMessageText(request.Format, request.Message)
+
 	dataWaRecipient, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.BaseRequest.Phone)
 	if err != nil {
 		return response, err
@@ -101,7 +215,7 @@ func (service serviceSend) SendText(ctx context.Context, request domainSend.Mess
 	if request.BaseRequest.Duration != nil && *request.BaseRequest.Duration > 0 {
 		msg.ExtendedTextMessage.ContextInfo.Expiration = proto.Uint32(uint32(*request.BaseRequest.Duration))
 	} else {
-		msg.ExtendedTextMessage.ContextInfo.Expiration = proto.Uint32(service.getDefaultEphemeralExpiration(request.BaseRequest.Phone))
+		msg.ExtendedTextMessage.ContextInfo.Expiration = proto.Uint32(service.getDefaultEphemeralExpiration(dataWaRecipient.String()))
 	}
 
 	parsedMentions := service.getMentionFromText(ctx, request.Message)
@@ -140,7 +254,7 @@ func (service serviceSend) SendText(ctx context.Context, request domainSend.Mess
 			if request.BaseRequest.Duration != nil && *request.BaseRequest.Duration > 0 {
 				ctxInfo.Expiration = proto.Uint32(uint32(*request.BaseRequest.Duration))
 			} else {
-				ctxInfo.Expiration = proto.Uint32(service.getDefaultEphemeralExpiration(participantJID))
+				ctxInfo.Expiration = proto.Uint32(service.getDefaultEphemeralExpiration(dataWaRecipient.String()))
 			}
 
 			// Preserve mentions
@@ -157,6 +271,22 @@ func (service serviceSend) SendText(ctx context.Context, request domainSend.Mess
 		}
 	}
 
+	// Attach a rich preview for the first link in the text unless the caller opted out
+	if !request.DisableLinkPreview {
+		if link := utils.ExtractFirstURL(request.Message); link != "" {
+			metadata, err := service.getLinkMetadata(link)
+			if err != nil {
+				logrus.Warnf("Failed to fetch link preview for %s: %v, sending without preview", link, err)
+			} else {
+				service.applyLinkPreview(ctx, msg.ExtendedTextMessage, link, metadata, dataWaRecipient)
+			}
+		}
+	}
+
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, request.Message); err != nil {
+		return response, err
+	}
+
 	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, request.Message)
 	if err != nil {
 		return response, err
@@ -172,6 +302,8 @@ func (service serviceSend) SendImage(ctx context.Context, request domainSend.Ima
 	if err != nil {
 		return response, err
 	}
+	request.Caption = utils.FormatMessageText(request.Format, request.Caption)
+
 	dataWaRecipient, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.Phone)
 	if err != nil {
 		return response, err
@@ -313,7 +445,12 @@ func (service serviceSend) SendImage(ctx context.Context, request domainSend.Ima
 	if request.Caption != "" {
 		caption = "🖼️ " + request.Caption
 	}
-	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, caption)
+
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, caption); err != nil {
+		return response, err
+	}
+
+	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, caption, whatsmeow.SendRequestExtra{MediaHandle: uploadedImage.Handle})
 	go func() {
 		errDelete := utils.RemoveFile(0, deletedItems...)
 		if errDelete != nil {
@@ -334,12 +471,26 @@ func (service serviceSend) SendFile(ctx context.Context, request domainSend.File
 	if err != nil {
 		return response, err
 	}
+	request.Caption = utils.FormatMessageText(request.Format, request.Caption)
+
 	dataWaRecipient, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.BaseRequest.Phone)
 	if err != nil {
 		return response, err
 	}
 
-	fileBytes := helpers.MultipartFormFileHeaderToBytes(request.File)
+	var (
+		fileBytes []byte
+		fileName  string
+	)
+	if request.FileURL != nil && *request.FileURL != "" {
+		fileBytes, fileName, err = utils.DownloadFileFromURL(*request.FileURL)
+		if err != nil {
+			return response, pkgError.InternalServerError(fmt.Sprintf("failed to download file from URL %v", err))
+		}
+	} else {
+		fileBytes = helpers.MultipartFormFileHeaderToBytes(request.File)
+		fileName = request.File.Filename
+	}
 	fileMimeType := http.DetectContentType(fileBytes)
 
 	// Send to WA server
@@ -352,11 +503,11 @@ func (service serviceSend) SendFile(ctx context.Context, request domainSend.File
 	msg := &waE2E.Message{DocumentMessage: &waE2E.DocumentMessage{
 		URL:           proto.String(uploadedFile.URL),
 		Mimetype:      proto.String(fileMimeType),
-		Title:         proto.String(request.File.Filename),
+		Title:         proto.String(fileName),
 		FileSHA256:    uploadedFile.FileSHA256,
 		FileLength:    proto.Uint64(uploadedFile.FileLength),
 		MediaKey:      uploadedFile.MediaKey,
-		FileName:      proto.String(request.File.Filename),
+		FileName:      proto.String(fileName),
 		FileEncSHA256: uploadedFile.FileEncSHA256,
 		DirectPath:    proto.String(uploadedFile.DirectPath),
 		Caption:       proto.String(request.Caption),
@@ -380,7 +531,12 @@ func (service serviceSend) SendFile(ctx context.Context, request domainSend.File
 	if request.Caption != "" {
 		caption = "📄 " + request.Caption
 	}
-	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, caption)
+
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, caption); err != nil {
+		return response, err
+	}
+
+	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, caption, whatsmeow.SendRequestExtra{MediaHandle: uploadedFile.Handle})
 	if err != nil {
 		return response, err
 	}
@@ -395,6 +551,8 @@ func (service serviceSend) SendVideo(ctx context.Context, request domainSend.Vid
 	if err != nil {
 		return response, err
 	}
+	request.Caption = utils.FormatMessageText(request.Format, request.Caption)
+
 	dataWaRecipient, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.BaseRequest.Phone)
 	if err != nil {
 		return response, err
@@ -555,7 +713,12 @@ func (service serviceSend) SendVideo(ctx context.Context, request domainSend.Vid
 	if request.Caption != "" {
 		caption = "🎥 " + request.Caption
 	}
-	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, caption)
+
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, caption); err != nil {
+		return response, err
+	}
+
+	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, caption, whatsmeow.SendRequestExtra{MediaHandle: uploaded.Handle})
 	if err != nil {
 		return response, err
 	}
@@ -598,6 +761,10 @@ func (service serviceSend) SendContact(ctx context.Context, request domainSend.C
 
 	content := "👤 " + request.ContactName
 
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, content); err != nil {
+		return response, err
+	}
+
 	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content)
 	if err != nil {
 		return response, err
@@ -608,8 +775,8 @@ func (service serviceSend) SendContact(ctx context.Context, request domainSend.C
 	return response, nil
 }
 
-func (service serviceSend) SendLink(ctx context.Context, request domainSend.LinkRequest) (response domainSend.GenericResponse, err error) {
-	err = validations.ValidateSendLink(ctx, request)
+func (service serviceSend) SendGroupInvite(ctx context.Context, request domainSend.GroupInviteRequest) (response domainSend.GenericResponse, err error) {
+	err = validations.ValidateSendGroupInvite(ctx, request)
 	if err != nil {
 		return response, err
 	}
@@ -618,25 +785,100 @@ func (service serviceSend) SendLink(ctx context.Context, request domainSend.Link
 		return response, err
 	}
 
-	metadata, err := utils.GetMetaDataFromURL(request.Link)
+	groupJID, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.GroupID)
 	if err != nil {
 		return response, err
 	}
 
-	// Log image dimensions if available, otherwise note it's a square image or dimensions not available
-	if metadata.Width != nil && metadata.Height != nil {
-		logrus.Debugf("Image dimensions: %dx%d", *metadata.Width, *metadata.Height)
-	} else {
-		logrus.Debugf("Image dimensions: Square image or dimensions not available")
+	groupInfo, err := whatsapp.GetClient().GetGroupInfo(groupJID)
+	if err != nil {
+		return response, err
+	}
+
+	link, err := whatsapp.GetClient().GetGroupInviteLink(groupJID, false)
+	if err != nil {
+		return response, err
+	}
+
+	// The card shares the group invite link, without an expiration it stays valid until the link is revoked
+	msg := &waE2E.Message{GroupInviteMessage: &waE2E.GroupInviteMessage{
+		GroupJID:      proto.String(groupJID.String()),
+		InviteCode:    proto.String(strings.TrimPrefix(link, whatsmeow.InviteLinkPrefix)),
+		GroupName:     proto.String(groupInfo.Name),
+		JPEGThumbnail: groupInviteThumbnail(groupJID),
+		Caption:       proto.String(request.Caption),
+	}}
+
+	// An expiration only retires the card, the link itself keeps working for anyone who has it
+	if request.Expiration > 0 {
+		msg.GroupInviteMessage.InviteExpiration = proto.Int64(time.Now().Add(time.Duration(request.Expiration) * time.Second).Unix())
+	}
+
+	if request.BaseRequest.IsForwarded {
+		msg.GroupInviteMessage.ContextInfo = &waE2E.ContextInfo{
+			IsForwarded:     proto.Bool(true),
+			ForwardingScore: proto.Uint32(100),
+		}
+	}
+
+	if request.BaseRequest.Duration != nil && *request.BaseRequest.Duration > 0 {
+		if msg.GroupInviteMessage.ContextInfo == nil {
+			msg.GroupInviteMessage.ContextInfo = &waE2E.ContextInfo{}
+		}
+		msg.GroupInviteMessage.ContextInfo.Expiration = proto.Uint32(uint32(*request.BaseRequest.Duration))
+	}
+
+	content := "👥 " + groupInfo.Name
+
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, content); err != nil {
+		return response, err
+	}
+
+	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content)
+	if err != nil {
+		return response, err
+	}
+
+	response.MessageID = ts.ID
+	response.Status = fmt.Sprintf("Group invite sent to %s (server timestamp: %s)", request.BaseRequest.Phone, ts.Timestamp.String())
+	return response, nil
+}
+
+// groupInviteThumbnail downloads the preview of the group photo for invite cards; a group without photo has none
+func groupInviteThumbnail(groupJID types.JID) []byte {
+	picture, err := whatsapp.GetClient().GetProfilePictureInfo(groupJID, &whatsmeow.GetProfilePictureParams{Preview: true})
+	if err != nil || picture == nil || picture.URL == "" {
+		return nil
+	}
+
+	thumbnail, _, err := utils.DownloadImageFromURL(picture.URL)
+	if err != nil {
+		logrus.Warnf("Failed to download photo of group %s for invite: %v", groupJID, err)
+		return nil
+	}
+	return thumbnail
+}
+
+func (service serviceSend) SendLink(ctx context.Context, request domainSend.LinkRequest) (response domainSend.GenericResponse, err error) {
+	err = validations.ValidateSendLink(ctx, request)
+	if err != nil {
+		return response, err
+	}
+	request.Caption = utils.FormatMessageText(request.Format, request.Caption)
+
+	dataWaRecipient, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.BaseRequest.Phone)
+	if err != nil {
+		return response, err
+	}
+
+	metadata, err := service.getLinkMetadata(request.Link)
+	if err != nil {
+		return response, err
 	}
 
 	// Create the message
 	msg := &waE2E.Message{ExtendedTextMessage: &waE2E.ExtendedTextMessage{
-		Text:          proto.String(fmt.Sprintf("%s\n%s", request.Caption, request.Link)),
-		Title:         proto.String(metadata.Title),
-		MatchedText:   proto.String(request.Link),
-		Description:   proto.String(metadata.Description),
-		JPEGThumbnail: metadata.ImageThumb,
+		Text: proto.String(fmt.Sprintf("%s\n%s", request.Caption, request.Link)),
 	}}
 
 	if request.BaseRequest.IsForwarded {
@@ -653,26 +895,17 @@ func (service serviceSend) SendLink(ctx context.Context, request domainSend.Link
 		msg.ExtendedTextMessage.ContextInfo.Expiration = proto.Uint32(uint32(*request.BaseRequest.Duration))
 	}
 
-	// If we have a thumbnail image, upload it to WhatsApp's servers
-	if len(metadata.ImageThumb) > 0 && metadata.Height != nil && metadata.Width != nil {
-		uploadedThumb, err := service.uploadMedia(ctx, whatsmeow.MediaLinkThumbnail, metadata.ImageThumb, dataWaRecipient)
-		if err == nil {
-			// Update the message with the uploaded thumbnail information
-			msg.ExtendedTextMessage.ThumbnailDirectPath = proto.String(uploadedThumb.DirectPath)
-			msg.ExtendedTextMessage.ThumbnailSHA256 = uploadedThumb.FileSHA256
-			msg.ExtendedTextMessage.ThumbnailEncSHA256 = uploadedThumb.FileEncSHA256
-			msg.ExtendedTextMessage.MediaKey = uploadedThumb.MediaKey
-			msg.ExtendedTextMessage.ThumbnailHeight = metadata.Height
-			msg.ExtendedTextMessage.ThumbnailWidth = metadata.Width
-		} else {
-			logrus.Warnf("Failed to upload thumbnail: %v, continue without uploaded thumbnail", err)
-		}
-	}
+	service.applyLinkPreview(ctx, msg.ExtendedTextMessage, request.Link, metadata, dataWaRecipient)
 
 	content := "🔗 " + request.Link
 	if request.Caption != "" {
 		content = "🔗 " + request.Caption
 	}
+
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, content); err != nil {
+		return response, err
+	}
+
 	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content)
 	if err != nil {
 		return response, err
@@ -717,6 +950,10 @@ func (service serviceSend) SendLocation(ctx context.Context, request domainSend.
 
 	content := "📍 " + request.Latitude + ", " + request.Longitude
 
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, content); err != nil {
+		return response, err
+	}
+
 	// Send WhatsApp Message Proto
 	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content)
 	if err != nil {
@@ -792,7 +1029,11 @@ func (service serviceSend) SendAudio(ctx context.Context, request domainSend.Aud
 
 	content := "🎵 Audio"
 
-	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content)
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, content); err != nil {
+		return response, err
+	}
+
+	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content, whatsmeow.SendRequestExtra{MediaHandle: audioUploaded.Handle})
 	if err != nil {
 		return response, err
 	}
@@ -823,16 +1064,93 @@ func (service serviceSend) SendPoll(ctx context.Context, request domainSend.Poll
 		msg.PollCreationMessage.ContextInfo.Expiration = proto.Uint32(uint32(*request.BaseRequest.Duration))
 	}
 
+	if err = service.simulateTyping(ctx, dataWaRecipient, request.BaseRequest, msg, content); err != nil {
+		return response, err
+	}
+
 	ts, err := service.wrapSendMessage(ctx, dataWaRecipient, msg, content)
 	if err != nil {
 		return response, err
 	}
 
+	// Keep the options and encryption secret so incoming votes can be decrypted and tallied
+	creator := ""
+	if whatsapp.GetClient().Store.ID != nil {
+		creator = whatsapp.GetClient().Store.ID.ToNonAD().String()
+	}
+	if err := service.chatStorageRepo.StorePoll(&domainChatStorage.Poll{
+		MessageID: ts.ID,
+		ChatJID:   dataWaRecipient.String(),
+		Creator:   creator,
+		Question:  request.Question,
+		Options:   request.Options,
+		MaxAnswer: request.MaxAnswer,
+		Secret:    msg.GetMessageContextInfo().GetMessageSecret(),
+		CreatedAt: ts.Timestamp,
+	}); err != nil {
+		logrus.Warnf("Failed to store poll %s: %v", ts.ID, err)
+	}
+
 	response.MessageID = ts.ID
 	response.Status = fmt.Sprintf("Send poll success %s (server timestamp: %s)", request.BaseRequest.Phone, ts.Timestamp.String())
 	return response, nil
 }
 
+func (service serviceSend) SendTemplate(ctx context.Context, request domainSend.TemplateRequest) (response domainSend.GenericResponse, err error) {
+	if err = validations.ValidateSendTemplate(ctx, request); err != nil {
+		return response, err
+	}
+
+	template, err := findTemplate(service.chatStorageRepo, request.TemplateName, request.Version)
+	if err != nil {
+		return response, err
+	}
+
+	body, mediaURL, err := renderTemplate(template, request.Variables)
+	if err != nil {
+		return response, err
+	}
+
+	switch template.MediaType {
+	case "image":
+		return service.SendImage(ctx, domainSend.ImageRequest{
+			BaseRequest: request.BaseRequest,
+			Caption:     body,
+			ImageURL:    &mediaURL,
+			Compress:    true,
+		})
+	case "video":
+		return service.SendVideo(ctx, domainSend.VideoRequest{
+			BaseRequest: request.BaseRequest,
+			Caption:     body,
+			VideoURL:    &mediaURL,
+		})
+	case "document":
+		return service.SendFile(ctx, domainSend.FileRequest{
+			BaseRequest: request.BaseRequest,
+			Caption:     body,
+			FileURL:     &mediaURL,
+		})
+	case "audio":
+		// Audio messages have no caption, so the body follows as a text message
+		if _, err = service.SendAudio(ctx, domainSend.AudioRequest{
+			BaseRequest: request.BaseRequest,
+			AudioURL:    &mediaURL,
+		}); err != nil {
+			return response, err
+		}
+		return service.SendText(ctx, domainSend.MessageRequest{
+			BaseRequest: request.BaseRequest,
+			Message:     body,
+		})
+	default:
+		return service.SendText(ctx, domainSend.MessageRequest{
+			BaseRequest: request.BaseRequest,
+			Message:     body,
+		})
+	}
+}
+
 func (service serviceSend) SendPresence(ctx context.Context, request domainSend.PresenceRequest) (response domainSend.GenericResponse, err error) {
 	err = validations.ValidateSendPresence(ctx, request)
 	if err != nil {
@@ -907,6 +1225,80 @@ func (service serviceSend) uploadMedia(ctx context.Context, mediaType whatsmeow.
 	return uploaded, err
 }
 
+// getLinkMetadata returns preview metadata for a link, served from storage while the cached entry is younger than the configured TTL
+func (service serviceSend) getLinkMetadata(link string) (metadata utils.Metadata, err error) {
+	cached, err := service.chatStorageRepo.GetLinkPreview(link)
+	if err != nil {
+		logrus.Warnf("Failed to read link preview cache for %s: %v", link, err)
+	} else if cached != nil && time.Since(cached.FetchedAt) < config.WhatsappLinkPreviewCacheTTL {
+		metadata = utils.Metadata{
+			Title:       cached.Title,
+			Description: cached.Description,
+			Image:       cached.ImageURL,
+			ImageThumb:  cached.Image,
+		}
+		if cached.Width > 0 && cached.Height > 0 {
+			metadata.Width = proto.Uint32(cached.Width)
+			metadata.Height = proto.Uint32(cached.Height)
+		}
+		return metadata, nil
+	}
+
+	metadata, err = utils.GetMetaDataFromURL(link)
+	if err != nil {
+		return metadata, err
+	}
+
+	preview := &domainChatStorage.LinkPreview{
+		URL:         link,
+		Title:       metadata.Title,
+		Description: metadata.Description,
+		ImageURL:    metadata.Image,
+		Image:       metadata.ImageThumb,
+		FetchedAt:   time.Now(),
+	}
+	if metadata.Width != nil && metadata.Height != nil {
+		preview.Width = *metadata.Width
+		preview.Height = *metadata.Height
+	}
+	if err := service.chatStorageRepo.StoreLinkPreview(preview); err != nil {
+		logrus.Warnf("Failed to cache link preview for %s: %v", link, err)
+	}
+
+	return metadata, nil
+}
+
+// applyLinkPreview fills the preview fields of a text message and uploads the high-resolution thumbnail when available
+func (service serviceSend) applyLinkPreview(ctx context.Context, ext *waE2E.ExtendedTextMessage, link string, metadata utils.Metadata, recipient types.JID) {
+	ext.Title = proto.String(metadata.Title)
+	ext.MatchedText = proto.String(link)
+	ext.Description = proto.String(metadata.Description)
+	ext.JPEGThumbnail = metadata.ImageThumb
+
+	// Log image dimensions if available, otherwise note it's a square image or dimensions not available
+	if metadata.Width != nil && metadata.Height != nil {
+		logrus.Debugf("Image dimensions: %dx%d", *metadata.Width, *metadata.Height)
+	} else {
+		logrus.Debugf("Image dimensions: Square image or dimensions not available")
+	}
+
+	// If we have a thumbnail image, upload it to WhatsApp's servers
+	if len(metadata.ImageThumb) > 0 && metadata.Height != nil && metadata.Width != nil {
+		uploadedThumb, err := service.uploadMedia(ctx, whatsmeow.MediaLinkThumbnail, metadata.ImageThumb, recipient)
+		if err == nil {
+			// Update the message with the uploaded thumbnail information
+			ext.ThumbnailDirectPath = proto.String(uploadedThumb.DirectPath)
+			ext.ThumbnailSHA256 = uploadedThumb.FileSHA256
+			ext.ThumbnailEncSHA256 = uploadedThumb.FileEncSHA256
+			ext.MediaKey = uploadedThumb.MediaKey
+			ext.ThumbnailHeight = metadata.Height
+			ext.ThumbnailWidth = metadata.Width
+		} else {
+			logrus.Warnf("Failed to upload thumbnail: %v, continue without uploaded thumbnail", err)
+		}
+	}
+}
+
 func (service serviceSend) getDefaultEphemeralExpiration(jid string) (expiration uint32) {
 	expiration = 0
 	if jid == "" {
@@ -922,5 +1314,14 @@ func (service serviceSend) getDefaultEphemeralExpiration(jid string) (expiration
 		expiration = chat.EphemeralExpiration
 	}
 
+	// Only a chat without a stored row is new, it starts with the account's default timer
+	if chat == nil {
+		if defaultExpiration, err := service.chatStorageRepo.GetDefaultEphemeralExpiration(); err == nil {
+			expiration = defaultExpiration
+		} else {
+			logrus.Warnf("Failed to get default disappearing timer: %v", err)
+		}
+	}
+
 	return expiration
 }
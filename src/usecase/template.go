@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
+	domainTemplate "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/template"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
+)
+
+type serviceTemplate struct {
+	chatStorageRepo domainChatStorage.IChatStorageRepository
+}
+
+func NewTemplateService(chatStorageRepo domainChatStorage.IChatStorageRepository) domainTemplate.ITemplateUsecase {
+	return &serviceTemplate{
+		chatStorageRepo: chatStorageRepo,
+	}
+}
+
+func (service serviceTemplate) SaveTemplate(ctx context.Context, request domainTemplate.SaveTemplateRequest) (response domainTemplate.TemplateResponse, err error) {
+	if err = validations.ValidateSaveTemplate(ctx, request); err != nil {
+		return response, err
+	}
+
+	template := &domainChatStorage.MessageTemplate{
+		Name:      request.Name,
+		Body:      request.Body,
+		MediaType: request.MediaType,
+		MediaURL:  request.MediaURL,
+	}
+	if err = service.chatStorageRepo.StoreTemplate(template); err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to store template: %v", err))
+	}
+
+	return toTemplateResponse(template), nil
+}
+
+func (service serviceTemplate) GetTemplate(ctx context.Context, request domainTemplate.GetTemplateRequest) (response domainTemplate.TemplateResponse, err error) {
+	if err = validations.ValidateGetTemplate(ctx, request); err != nil {
+		return response, err
+	}
+
+	template, err := findTemplate(service.chatStorageRepo, request.Name, request.Version)
+	if err != nil {
+		return response, err
+	}
+
+	return toTemplateResponse(template), nil
+}
+
+func (service serviceTemplate) ListTemplates(_ context.Context) (response []domainTemplate.TemplateResponse, err error) {
+	templates, err := service.chatStorageRepo.GetTemplates()
+	if err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to get templates: %v", err))
+	}
+
+	response = make([]domainTemplate.TemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, toTemplateResponse(template))
+	}
+	return response, nil
+}
+
+func (service serviceTemplate) ListTemplateVersions(ctx context.Context, request domainTemplate.GetTemplateRequest) (response []domainTemplate.TemplateResponse, err error) {
+	if err = validations.ValidateGetTemplate(ctx, request); err != nil {
+		return response, err
+	}
+
+	templates, err := service.chatStorageRepo.GetTemplateVersions(request.Name)
+	if err != nil {
+		return response, pkgError.InternalServerError(fmt.Sprintf("failed to get template versions: %v", err))
+	}
+	if len(templates) == 0 {
+		return response, pkgError.ValidationError(fmt.Sprintf("template %s not found", request.Name))
+	}
+
+	response = make([]domainTemplate.TemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, toTemplateResponse(template))
+	}
+	return response, nil
+}
+
+func (service serviceTemplate) DeleteTemplate(ctx context.Context, request domainTemplate.GetTemplateRequest) (err error) {
+	if err = validations.ValidateGetTemplate(ctx, request); err != nil {
+		return err
+	}
+
+	if _, err = findTemplate(service.chatStorageRepo, request.Name, 0); err != nil {
+		return err
+	}
+
+	return service.chatStorageRepo.DeleteTemplate(request.Name)
+}
+
+func (service serviceTemplate) PreviewTemplate(ctx context.Context, request domainTemplate.PreviewTemplateRequest) (response domainTemplate.PreviewTemplateResponse, err error) {
+	if err = validations.ValidatePreviewTemplate(ctx, request); err != nil {
+		return response, err
+	}
+
+	template, err := findTemplate(service.chatStorageRepo, request.Name, request.Version)
+	if err != nil {
+		return response, err
+	}
+
+	body, mediaURL, err := renderTemplate(template, request.Variables)
+	if err != nil {
+		return response, err
+	}
+
+	response.Name = template.Name
+	response.Version = template.Version
+	response.Body = body
+	response.MediaType = template.MediaType
+	response.MediaURL = mediaURL
+	return response, nil
+}
+
+// findTemplate loads a template version (0 for the latest) and reports a missing template as a validation error
+func findTemplate(repo domainChatStorage.IChatStorageRepository, name string, version int) (*domainChatStorage.MessageTemplate, error) {
+	template, err := repo.GetTemplate(name, version)
+	if err != nil {
+		return nil, pkgError.InternalServerError(fmt.Sprintf("failed to get template: %v", err))
+	}
+	if template == nil {
+		if version > 0 {
+			return nil, pkgError.ValidationError(fmt.Sprintf("template %s version %d not found", name, version))
+		}
+		return nil, pkgError.ValidationError(fmt.Sprintf("template %s not found", name))
+	}
+	return template, nil
+}
+
+// renderTemplate renders the body and media URL of a template with the given variables
+func renderTemplate(template *domainChatStorage.MessageTemplate, variables map[string]any) (body string, mediaURL string, err error) {
+	body, err = utils.RenderMessageTemplate(template.Body, variables)
+	if err != nil {
+		return "", "", pkgError.ValidationError(err.Error())
+	}
+
+	if template.MediaURL != "" {
+		mediaURL, err = utils.RenderMessageTemplate(template.MediaURL, variables)
+		if err != nil {
+			return "", "", pkgError.ValidationError("media_url: " + err.Error())
+		}
+	}
+
+	return body, mediaURL, nil
+}
+
+func toTemplateResponse(template *domainChatStorage.MessageTemplate) domainTemplate.TemplateResponse {
+	variables, err := utils.TemplateVariables(template.Body)
+	if err != nil {
+		variables = []string{}
+	}
+	if template.MediaURL != "" {
+		if mediaVariables, err := utils.TemplateVariables(template.MediaURL); err == nil {
+			variables = mergeVariableNames(variables, mediaVariables)
+		}
+	}
+
+	return domainTemplate.TemplateResponse{
+		Name:      template.Name,
+		Version:   template.Version,
+		Body:      template.Body,
+		MediaType: template.MediaType,
+		MediaURL:  template.MediaURL,
+		Variables: variables,
+		CreatedAt: template.CreatedAt,
+	}
+}
+
+// mergeVariableNames appends the names from extra that are not already in names
+func mergeVariableNames(names []string, extra []string) []string {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		seen[name] = true
+	}
+	for _, name := range extra {
+		if !seen[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
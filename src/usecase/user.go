@@ -6,25 +6,35 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"strings"
 	"time"
 
+	domainChatStorage "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/chatstorage"
 	domainUser "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/user"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/infrastructure/whatsapp"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/validations"
 	"github.com/disintegration/imaging"
+	"github.com/sirupsen/logrus"
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/appstate"
 	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
 )
 
+// maxBulkCheckPhones caps a single bulk registration check
+const maxBulkCheckPhones = 5000
+
 type serviceUser struct {
 	// Remove the WaCli field - we'll use the global client instead
+	chatStorageRepo domainChatStorage.IChatStorageRepository
 }
 
-func NewUserService() domainUser.IUserUsecase {
-	return &serviceUser{}
+func NewUserService(chatStorageRepo domainChatStorage.IChatStorageRepository) domainUser.IUserUsecase {
+	return &serviceUser{
+		chatStorageRepo: chatStorageRepo,
+	}
 }
 
 func (service serviceUser) Info(ctx context.Context, request domainUser.InfoRequest) (response domainUser.InfoResponse, err error) {
@@ -153,11 +163,56 @@ func (service serviceUser) MyPrivacySetting(ctx context.Context) (response domai
 		return
 	}
 
-	response.GroupAdd = string(resp.GroupAdd)
-	response.Status = string(resp.Status)
-	response.ReadReceipts = string(resp.ReadReceipts)
-	response.Profile = string(resp.Profile)
-	return response, nil
+	return privacySettingResponse(*resp), nil
+}
+
+func (service serviceUser) UpdatePrivacySetting(ctx context.Context, request domainUser.UpdatePrivacySettingRequest) (response domainUser.MyPrivacySettingResponse, err error) {
+	if err = validations.ValidateUpdatePrivacySetting(ctx, request); err != nil {
+		return response, err
+	}
+
+	utils.MustLogin(whatsapp.GetClient())
+
+	// The about text visibility is the "status" privacy category on WhatsApp
+	profile := []struct {
+		name  types.PrivacySettingType
+		value string
+	}{
+		{types.PrivacySettingTypeLastSeen, request.LastSeen},
+		{types.PrivacySettingTypeOnline, request.Online},
+		{types.PrivacySettingTypeProfile, request.Profile},
+		{types.PrivacySettingTypeStatus, request.About},
+		{types.PrivacySettingTypeReadReceipts, request.ReadReceipts},
+		{types.PrivacySettingTypeGroupAdd, request.GroupAdd},
+		{types.PrivacySettingTypeCallAdd, request.CallAdd},
+	}
+
+	var settings types.PrivacySettings
+	for _, setting := range profile {
+		if setting.value == "" {
+			continue
+		}
+
+		settings, err = whatsapp.GetClient().SetPrivacySetting(ctx, setting.name, types.PrivacySetting(setting.value))
+		if err != nil {
+			return response, fmt.Errorf("failed to update %s privacy: %w", setting.name, err)
+		}
+	}
+
+	return privacySettingResponse(settings), nil
+}
+
+func privacySettingResponse(settings types.PrivacySettings) domainUser.MyPrivacySettingResponse {
+	return domainUser.MyPrivacySettingResponse{
+		GroupAdd:     string(settings.GroupAdd),
+		LastSeen:     string(settings.LastSeen),
+		About:        string(settings.Status),
+		Status:       string(settings.Status),
+		Profile:      string(settings.Profile),
+		ReadReceipts: string(settings.ReadReceipts),
+		Online:       string(settings.Online),
+		CallAdd:      string(settings.CallAdd),
+	}
 }
 
 func (service serviceUser) MyListContacts(ctx context.Context) (response domainUser.MyListContactsResponse, err error) {
@@ -242,16 +297,156 @@ func (service serviceUser) ChangePushName(ctx context.Context, request domainUse
 	return nil
 }
 
+func (service serviceUser) ChangeAbout(ctx context.Context, request domainUser.ChangeAboutRequest) (err error) {
+	if err = validations.ValidateChangeAbout(ctx, request); err != nil {
+		return err
+	}
+
+	utils.MustLogin(whatsapp.GetClient())
+
+	return whatsapp.GetClient().SetStatusMessage(request.About)
+}
+
+// ApplyProfile brings the account in line with a declarative profile. Every section is applied even if an
+// earlier one fails, and the failures are returned together.
+func (service serviceUser) ApplyProfile(ctx context.Context, profile domainUser.DeclarativeProfile) (err error) {
+	if err = validations.ValidateApplyProfile(ctx, profile); err != nil {
+		return err
+	}
+
+	utils.MustLogin(whatsapp.GetClient())
+
+	var errs []error
+	if profile.PushName != "" && profile.PushName != whatsapp.GetClient().Store.PushName {
+		if err := service.ChangePushName(ctx, domainUser.ChangePushNameRequest{PushName: profile.PushName}); err != nil {
+			errs = append(errs, fmt.Errorf("push_name: %w", err))
+		}
+	}
+	if profile.About != nil {
+		if err := service.ChangeAbout(ctx, domainUser.ChangeAboutRequest{About: *profile.About}); err != nil {
+			errs = append(errs, fmt.Errorf("about: %w", err))
+		}
+	}
+	if profile.Privacy != nil {
+		if _, err := service.UpdatePrivacySetting(ctx, *profile.Privacy); err != nil {
+			errs = append(errs, fmt.Errorf("privacy: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func (service serviceUser) IsOnWhatsApp(ctx context.Context, request domainUser.CheckRequest) (response domainUser.CheckResponse, err error) {
 	utils.MustLogin(whatsapp.GetClient())
 
-	utils.SanitizePhone(&request.Phone)
+	if err = utils.SanitizePhone(&request.Phone); err != nil {
+		return response, err
+	}
 
 	response.IsOnWhatsApp = utils.IsOnWhatsapp(whatsapp.GetClient(), request.Phone)
 
 	return response, nil
 }
 
+func (service serviceUser) BulkIsOnWhatsApp(ctx context.Context, request domainUser.BulkCheckRequest) (response domainUser.BulkCheckResponse, err error) {
+	if err = validations.ValidateBulkCheck(ctx, request); err != nil {
+		return response, err
+	}
+
+	numbers, err := bulkCheckNumbers(request)
+	if err != nil {
+		return response, err
+	}
+	if len(numbers) > maxBulkCheckPhones {
+		return response, pkgError.ValidationError(fmt.Sprintf("phones: cannot check more than %d numbers at once.", maxBulkCheckPhones))
+	}
+
+	utils.MustLogin(whatsapp.GetClient())
+
+	normalized := make(map[string]string, len(numbers))
+	invalid := make(map[string]string)
+	var valid []string
+	for _, number := range numbers {
+		phone, err := utils.NormalizePhoneNumber(number)
+		if err != nil {
+			invalid[number] = err.Error()
+			continue
+		}
+		normalized[number] = phone
+		valid = append(valid, phone)
+	}
+
+	checks, err := utils.CheckOnWhatsApp(whatsapp.GetClient(), valid)
+	if err != nil {
+		return response, fmt.Errorf("failed to check WhatsApp registration: %w", err)
+	}
+	checked := make(map[string]utils.WhatsAppCheckResult, len(checks))
+	for _, check := range checks {
+		checked[check.Phone] = check
+	}
+
+	response.Total = len(numbers)
+	response.Results = make([]domainUser.BulkCheckResult, 0, len(numbers))
+	for _, number := range numbers {
+		if reason, ok := invalid[number]; ok {
+			response.Invalid++
+			response.Results = append(response.Results, domainUser.BulkCheckResult{Phone: number, Error: reason})
+			continue
+		}
+
+		check := checked[normalized[number]]
+		if check.IsOnWhatsApp {
+			response.OnWhatsApp++
+		} else {
+			response.NotOnWhatsApp++
+		}
+		if check.Cached {
+			response.Cached++
+		}
+		response.Results = append(response.Results, domainUser.BulkCheckResult{
+			Phone:        number,
+			JID:          check.JID,
+			IsOnWhatsApp: check.IsOnWhatsApp,
+			IsBusiness:   check.IsBusiness,
+			VerifiedName: check.VerifiedName,
+			CheckedAt:    check.CheckedAt.UTC().Format(time.RFC3339),
+			Cached:       check.Cached,
+		})
+	}
+
+	return response, nil
+}
+
+// bulkCheckNumbers collects the numbers of the CSV file and the phones list without duplicates, in input order
+func bulkCheckNumbers(request domainUser.BulkCheckRequest) ([]string, error) {
+	var numbers []string
+	if request.File != nil {
+		file, err := request.File.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		if numbers, err = utils.ParsePhoneNumbersCSV(file); err != nil {
+			return nil, pkgError.ValidationError(fmt.Sprintf("file: %s.", err.Error()))
+		}
+	}
+
+	seen := make(map[string]bool, len(numbers))
+	for _, number := range numbers {
+		seen[number] = true
+	}
+	for _, phone := range request.Phones {
+		number := strings.TrimSpace(strings.Split(phone, "@")[0])
+		if !seen[number] {
+			seen[number] = true
+			numbers = append(numbers, number)
+		}
+	}
+
+	return numbers, nil
+}
+
 func (service serviceUser) BusinessProfile(ctx context.Context, request domainUser.BusinessProfileRequest) (response domainUser.BusinessProfileResponse, err error) {
 	err = validations.ValidateBusinessProfile(ctx, request)
 	if err != nil {
@@ -268,8 +463,157 @@ func (service serviceUser) BusinessProfile(ctx context.Context, request domainUs
 		return response, err
 	}
 
+	return businessProfileResponse(dataWaRecipient, profile), nil
+}
+
+func (service serviceUser) BlockContact(ctx context.Context, request domainUser.BlockContactRequest) (response domainUser.BlocklistResponse, err error) {
+	return service.updateBlocklist(ctx, request, events.BlocklistChangeActionBlock)
+}
+
+func (service serviceUser) UnblockContact(ctx context.Context, request domainUser.BlockContactRequest) (response domainUser.BlocklistResponse, err error) {
+	return service.updateBlocklist(ctx, request, events.BlocklistChangeActionUnblock)
+}
+
+func (service serviceUser) GetBlocklist(_ context.Context) (response domainUser.BlocklistResponse, err error) {
+	utils.MustLogin(whatsapp.GetClient())
+
+	list, err := whatsapp.GetClient().GetBlocklist()
+	if err != nil {
+		return response, err
+	}
+	whatsapp.SetBlocklist(list)
+
+	return blocklistResponse(list), nil
+}
+
+// updateBlocklist blocks or unblocks a contact and returns the block list as it is after the change
+func (service serviceUser) updateBlocklist(ctx context.Context, request domainUser.BlockContactRequest, action events.BlocklistChangeAction) (response domainUser.BlocklistResponse, err error) {
+	if err = validations.ValidateBlockContact(ctx, request); err != nil {
+		return response, err
+	}
+
+	utils.MustLogin(whatsapp.GetClient())
+
+	if err := utils.SanitizePhone(&request.Phone); err != nil {
+		return response, err
+	}
+	jid, err := utils.ParseJID(request.Phone)
+	if err != nil {
+		return response, err
+	}
+
+	list, err := whatsapp.GetClient().UpdateBlocklist(jid.ToNonAD(), action)
+	if err != nil {
+		return response, err
+	}
+	whatsapp.SetBlocklist(list)
+
+	return blocklistResponse(list), nil
+}
+
+func blocklistResponse(list *types.Blocklist) domainUser.BlocklistResponse {
+	response := domainUser.BlocklistResponse{
+		DHash: list.DHash,
+		Data:  make([]string, 0, len(list.JIDs)),
+	}
+	for _, jid := range list.JIDs {
+		response.Data = append(response.Data, jid.String())
+	}
+	return response
+}
+
+func (service serviceUser) SubscribePresence(ctx context.Context, request domainUser.SubscribePresenceRequest) (response domainUser.PresenceResponse, err error) {
+	if err = validations.ValidateSubscribePresence(ctx, request); err != nil {
+		return response, err
+	}
+
+	jid, err := utils.ValidateJidWithLogin(whatsapp.GetClient(), request.Phone)
+	if err != nil {
+		return response, err
+	}
+	jid = jid.ToNonAD()
+
+	// The server only sends presence updates to clients that are online themselves
+	if err = whatsapp.GetClient().SendPresence(types.PresenceAvailable); err != nil {
+		logrus.Warnf("Failed to send available presence before subscribing: %v", err)
+	}
+	if err = whatsapp.GetClient().SubscribePresence(jid); err != nil {
+		return response, fmt.Errorf("failed to subscribe to presence: %w", err)
+	}
+
+	if err = service.chatStorageRepo.SetPresenceSubscribed(jid.String(), true); err != nil {
+		return response, err
+	}
+
+	return service.presenceResponse(jid)
+}
+
+// UnsubscribePresence stops forwarding presence updates of a contact and stops renewing the subscription on reconnect.
+// WhatsApp has no unsubscribe request, the server stops sending updates once the connection is renewed.
+func (service serviceUser) UnsubscribePresence(ctx context.Context, request domainUser.SubscribePresenceRequest) (response domainUser.PresenceResponse, err error) {
+	if err = validations.ValidateSubscribePresence(ctx, request); err != nil {
+		return response, err
+	}
+
+	if err = utils.SanitizePhone(&request.Phone); err != nil {
+		return response, err
+	}
+	jid, err := utils.ParseJID(request.Phone)
+	if err != nil {
+		return response, err
+	}
+	jid = jid.ToNonAD()
+
+	if err = service.chatStorageRepo.SetPresenceSubscribed(jid.String(), false); err != nil {
+		return response, err
+	}
+
+	return service.presenceResponse(jid)
+}
+
+func (service serviceUser) GetPresence(ctx context.Context, request domainUser.PresenceRequest) (response domainUser.PresenceResponse, err error) {
+	if err = validations.ValidatePresence(ctx, request); err != nil {
+		return response, err
+	}
+
+	if err = utils.SanitizePhone(&request.Phone); err != nil {
+		return response, err
+	}
+	jid, err := utils.ParseJID(request.Phone)
+	if err != nil {
+		return response, err
+	}
+
+	return service.presenceResponse(jid.ToNonAD())
+}
+
+// presenceResponse returns the stored presence of a contact, only the JID is set when nothing is known yet
+func (service serviceUser) presenceResponse(jid types.JID) (response domainUser.PresenceResponse, err error) {
+	response.JID = jid.String()
+
+	presence, err := service.chatStorageRepo.GetPresence(jid.String())
+	if err != nil || presence == nil {
+		return response, err
+	}
+
+	response.Subscribed = presence.Subscribed
+	response.IsOnline = presence.IsOnline
+	response.ChatState = presence.ChatState
+	response.ChatStateChat = presence.ChatStateChat
+	response.UpdatedAt = presence.UpdatedAt.UTC().Format(time.RFC3339)
+	if presence.LastSeen != nil {
+		response.LastSeen = presence.LastSeen.UTC().Format(time.RFC3339)
+	}
+	if presence.ChatStateAt != nil {
+		response.ChatStateAt = presence.ChatStateAt.UTC().Format(time.RFC3339)
+	}
+	return response, nil
+}
+
+// businessProfileResponse converts a whatsmeow business profile to the response format
+func businessProfileResponse(jid types.JID, profile *types.BusinessProfile) (response domainUser.BusinessProfileResponse) {
 	// Convert profile to response format
-	response.JID = dataWaRecipient.String()
+	response.JID = jid.String()
 	response.Email = profile.Email
 	response.Address = profile.Address
 
@@ -301,5 +645,5 @@ func (service serviceUser) BusinessProfile(ctx context.Context, request domainUs
 		})
 	}
 
-	return response, nil
+	return response
 }
@@ -56,3 +56,62 @@ func ValidatePinChat(ctx context.Context, request *domainChat.PinChatRequest) er
 
 	return nil
 }
+
+// validDisappearingTimer accepts the timers WhatsApp clients offer: off, 24h, 7d and 90d. Aliases that whatsmeow
+// would also parse, such as "1" or "day", are rejected so responses echo one of these names.
+var validDisappearingTimer = validation.In("off", "24h", "7d", "90d").Error("must be one of off, 24h, 7d or 90d")
+
+func ValidateSetDisappearingTimer(ctx context.Context, request *domainChat.SetDisappearingTimerRequest) error {
+	err := validation.ValidateStructWithContext(ctx, request,
+		validation.Field(&request.ChatJID, validation.Required),
+		validation.Field(&request.Timer, validation.Required, validDisappearingTimer),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateSetDefaultDisappearingTimer(ctx context.Context, request *domainChat.SetDefaultDisappearingTimerRequest) error {
+	err := validation.ValidateStructWithContext(ctx, request,
+		validation.Field(&request.Timer, validation.Required, validDisappearingTimer),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateGetPinnedMessages(ctx context.Context, request *domainChat.GetPinnedMessagesRequest) error {
+	err := validation.ValidateStructWithContext(ctx, request,
+		validation.Field(&request.ChatJID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateListCalls(ctx context.Context, request *domainChat.ListCallsRequest) error {
+	// Set default limit if not provided
+	if request.Limit == 0 {
+		request.Limit = 25
+	}
+
+	err := validation.ValidateStructWithContext(ctx, request,
+		validation.Field(&request.Limit, validation.Min(1), validation.Max(100)),
+		validation.Field(&request.Offset, validation.Min(0)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
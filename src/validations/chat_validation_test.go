@@ -183,3 +183,162 @@ func TestValidatePinChat(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSetDisappearingTimer(t *testing.T) {
+	type args struct {
+		request domainChat.SetDisappearingTimerRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with 24h timer",
+			args: args{request: domainChat.SetDisappearingTimerRequest{
+				ChatJID: "6289685028129@s.whatsapp.net",
+				Timer:   "24h",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success turning timer off in a group",
+			args: args{request: domainChat.SetDisappearingTimerRequest{
+				ChatJID: "120363024512399999@g.us",
+				Timer:   "off",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty chat_jid",
+			args: args{request: domainChat.SetDisappearingTimerRequest{
+				Timer: "7d",
+			}},
+			err: pkgError.ValidationError("chat_jid: cannot be blank."),
+		},
+		{
+			name: "should error with empty timer",
+			args: args{request: domainChat.SetDisappearingTimerRequest{
+				ChatJID: "6289685028129@s.whatsapp.net",
+			}},
+			err: pkgError.ValidationError("timer: cannot be blank."),
+		},
+		{
+			name: "should error with unsupported timer",
+			args: args{request: domainChat.SetDisappearingTimerRequest{
+				ChatJID: "6289685028129@s.whatsapp.net",
+				Timer:   "3d",
+			}},
+			err: pkgError.ValidationError("timer: must be one of off, 24h, 7d or 90d."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetDisappearingTimer(context.Background(), &tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateSetDefaultDisappearingTimer(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainChat.SetDefaultDisappearingTimerRequest
+		err     any
+	}{
+		{
+			name:    "should success with 90d timer",
+			request: domainChat.SetDefaultDisappearingTimerRequest{Timer: "90d"},
+			err:     nil,
+		},
+		{
+			name:    "should error with unsupported timer",
+			request: domainChat.SetDefaultDisappearingTimerRequest{Timer: "1h"},
+			err:     pkgError.ValidationError("timer: must be one of off, 24h, 7d or 90d."),
+		},
+		{
+			name:    "should error with whatsmeow day alias",
+			request: domainChat.SetDefaultDisappearingTimerRequest{Timer: "day"},
+			err:     pkgError.ValidationError("timer: must be one of off, 24h, 7d or 90d."),
+		},
+		{
+			name:    "should error with numeric alias",
+			request: domainChat.SetDefaultDisappearingTimerRequest{Timer: "1"},
+			err:     pkgError.ValidationError("timer: must be one of off, 24h, 7d or 90d."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetDefaultDisappearingTimer(context.Background(), &tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateGetPinnedMessages(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainChat.GetPinnedMessagesRequest
+		err     any
+	}{
+		{
+			name:    "should success with chat jid",
+			request: domainChat.GetPinnedMessagesRequest{ChatJID: "120363024512399999@g.us"},
+			err:     nil,
+		},
+		{
+			name:    "should error with empty chat jid",
+			request: domainChat.GetPinnedMessagesRequest{},
+			err:     pkgError.ValidationError("chat_jid: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGetPinnedMessages(context.Background(), &tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateListCalls(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainChat.ListCallsRequest
+		limit   int
+		err     any
+	}{
+		{
+			name:    "should set default limit",
+			request: domainChat.ListCallsRequest{},
+			limit:   25,
+		},
+		{
+			name:    "should success with caller filter",
+			request: domainChat.ListCallsRequest{Phone: "6289685024051@s.whatsapp.net", Limit: 100, Offset: 10},
+			limit:   100,
+		},
+		{
+			name:    "should error with limit too high",
+			request: domainChat.ListCallsRequest{Limit: 101},
+			limit:   101,
+			err:     pkgError.ValidationError("limit: must be no greater than 100."),
+		},
+		{
+			name:    "should error with negative offset",
+			request: domainChat.ListCallsRequest{Limit: 25, Offset: -1},
+			limit:   25,
+			err:     pkgError.ValidationError("offset: must be no less than 0."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateListCalls(context.Background(), &tt.request)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.limit, tt.request.Limit)
+		})
+	}
+}
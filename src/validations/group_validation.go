@@ -2,11 +2,13 @@ package validations
 
 import (
 	"context"
+	"time"
 
 	domainGroup "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/group"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
 )
 
 func ValidateJoinGroupWithLink(ctx context.Context, request domainGroup.JoinGroupWithLinkRequest) error {
@@ -33,6 +35,26 @@ func ValidateGetGroupInfoFromLink(ctx context.Context, request domainGroup.GetGr
 	return nil
 }
 
+func ValidateAcceptGroupInvite(ctx context.Context, request domainGroup.AcceptGroupInviteRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+		validation.Field(&request.Inviter, validation.Required),
+		validation.Field(&request.InviteCode, validation.Required),
+		validation.Field(&request.InviteExpiration, validation.Min(int64(0))),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	// Cards sharing an invite link carry no expiration
+	if request.InviteExpiration > 0 && time.Unix(request.InviteExpiration, 0).Before(time.Now()) {
+		return pkgError.ValidationError("invite_expiration: the invite has expired.")
+	}
+
+	return nil
+}
+
 func ValidateLeaveGroup(ctx context.Context, request domainGroup.LeaveGroupRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.GroupID, validation.Required),
@@ -48,7 +70,7 @@ func ValidateLeaveGroup(ctx context.Context, request domainGroup.LeaveGroupReque
 func ValidateCreateGroup(ctx context.Context, request domainGroup.CreateGroupRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Title, validation.Required),
-		validation.Field(&request.Participants, validation.Required),
+		validation.Field(&request.Participants, validation.When(!request.IsCommunity, validation.Required)),
 		validation.Field(&request.Participants, validation.Each(validation.Required)),
 	)
 
@@ -56,6 +78,10 @@ func ValidateCreateGroup(ctx context.Context, request domainGroup.CreateGroupReq
 		return pkgError.ValidationError(err.Error())
 	}
 
+	if request.IsCommunity && request.CommunityID != "" {
+		return pkgError.ValidationError("a community cannot be created inside another community")
+	}
+
 	return nil
 }
 
@@ -73,6 +99,26 @@ func ValidateParticipant(ctx context.Context, request domainGroup.ParticipantReq
 	return nil
 }
 
+func ValidateBulkAddParticipants(ctx context.Context, request domainGroup.BulkAddParticipantsRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+		validation.Field(&request.Participants, validation.Each(validation.Required)),
+		validation.Field(&request.InviteMessage, validation.RuneLength(0, 1024)),
+		validation.Field(&request.BatchSize, validation.Min(0), validation.Max(50)),
+		validation.Field(&request.BatchDelay, validation.Min(0), validation.Max(300)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if request.File == nil && len(request.Participants) == 0 {
+		return pkgError.ValidationError("file: a CSV file or participants is required.")
+	}
+
+	return nil
+}
+
 func ValidateGetGroupRequestParticipants(ctx context.Context, request domainGroup.GetGroupRequestParticipantsRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.GroupID, validation.Required),
@@ -216,3 +262,148 @@ func ValidateGetGroupInviteLink(ctx context.Context, request domainGroup.GetGrou
 
 	return nil
 }
+
+func ValidateLinkCommunityGroup(ctx context.Context, request domainGroup.LinkCommunityGroupRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.CommunityID, validation.Required),
+		validation.Field(&request.GroupID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateCommunity(ctx context.Context, request domainGroup.CommunityRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.CommunityID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateCommunityAnnouncement(ctx context.Context, request domainGroup.CommunityAnnouncementRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.CommunityID, validation.Required),
+		validation.Field(&request.Message, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateSetGroupJoinApproval(ctx context.Context, request domainGroup.SetGroupJoinApprovalRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateSetGroupMemberAddMode(ctx context.Context, request domainGroup.SetGroupMemberAddModeRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+		validation.Field(&request.Mode, validation.Required, validation.In(
+			string(types.GroupMemberAddModeAdmin),
+			string(types.GroupMemberAddModeAllMember),
+		).Error("must be one of admin_add or all_member_add")),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateSetGroupEphemeral(ctx context.Context, request domainGroup.SetGroupEphemeralRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+		validation.Field(&request.Timer, validation.Required, validDisappearingTimer),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateRevokeGroupInviteLink(ctx context.Context, request domainGroup.RevokeGroupInviteLinkRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateGroupMembership(ctx context.Context, request domainGroup.GroupMembershipRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.Required),
+		validation.Field(&request.At, validation.Date(time.RFC3339)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateGroupGrowth(ctx context.Context, request domainGroup.GroupMembershipPeriodRequest) error {
+	return validateGroupMembershipPeriod(ctx, request, true)
+}
+
+// ValidateGroupChurn accepts an empty group_id, which reports churn for every tracked group
+func ValidateGroupChurn(ctx context.Context, request domainGroup.GroupMembershipPeriodRequest) error {
+	return validateGroupMembershipPeriod(ctx, request, false)
+}
+
+// maxGroupMembershipPeriod bounds growth and churn reports, which are built one day at a time
+const maxGroupMembershipPeriod = 366 * 24 * time.Hour
+
+func validateGroupMembershipPeriod(ctx context.Context, request domainGroup.GroupMembershipPeriodRequest, requireGroup bool) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.GroupID, validation.When(requireGroup, validation.Required)),
+		validation.Field(&request.From, validation.Date(time.RFC3339)),
+		validation.Field(&request.To, validation.Date(time.RFC3339)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	// Without from the period is a fixed number of days before to, so only an explicit from can make it too long
+	if request.From != "" {
+		from, _ := time.Parse(time.RFC3339, request.From)
+		to := time.Now()
+		if request.To != "" {
+			to, _ = time.Parse(time.RFC3339, request.To)
+		}
+		if request.To != "" && !from.Before(to) {
+			return pkgError.ValidationError("from: must be before to.")
+		}
+		if to.Sub(from) > maxGroupMembershipPeriod {
+			return pkgError.ValidationError("from: the period must not be longer than 366 days.")
+		}
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package validations
 
 import (
 	"context"
+	"mime/multipart"
 	"testing"
+	"time"
 
 	domainGroup "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/group"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
@@ -133,6 +135,32 @@ func TestValidateCreateGroup(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			name: "should success creating a community without participants",
+			args: args{request: domainGroup.CreateGroupRequest{
+				Title:       "Regional Stores",
+				IsCommunity: true,
+			}},
+			err: nil,
+		},
+		{
+			name: "should success creating a group inside a community",
+			args: args{request: domainGroup.CreateGroupRequest{
+				Title:        "North Region",
+				Participants: []string{"+6281234567890@s.whatsapp.net"},
+				CommunityID:  "120363024512399999@g.us",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error creating a community inside a community",
+			args: args{request: domainGroup.CreateGroupRequest{
+				Title:       "Regional Stores",
+				IsCommunity: true,
+				CommunityID: "120363024512399999@g.us",
+			}},
+			err: pkgError.ValidationError("a community cannot be created inside another community"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -706,3 +734,441 @@ func TestValidateGroupInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLinkCommunityGroup(t *testing.T) {
+	type args struct {
+		request domainGroup.LinkCommunityGroupRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with community and group",
+			args: args{request: domainGroup.LinkCommunityGroupRequest{
+				CommunityID: "120363024512399999@g.us",
+				GroupID:     "120363024512399998@g.us",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty community id",
+			args: args{request: domainGroup.LinkCommunityGroupRequest{
+				GroupID: "120363024512399998@g.us",
+			}},
+			err: pkgError.ValidationError("community_id: cannot be blank."),
+		},
+		{
+			name: "should error with empty group id",
+			args: args{request: domainGroup.LinkCommunityGroupRequest{
+				CommunityID: "120363024512399999@g.us",
+			}},
+			err: pkgError.ValidationError("group_id: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLinkCommunityGroup(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateCommunityAnnouncement(t *testing.T) {
+	type args struct {
+		request domainGroup.CommunityAnnouncementRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with community and message",
+			args: args{request: domainGroup.CommunityAnnouncementRequest{
+				CommunityID: "120363024512399999@g.us",
+				Message:     "Stores close early on Friday",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty message",
+			args: args{request: domainGroup.CommunityAnnouncementRequest{
+				CommunityID: "120363024512399999@g.us",
+			}},
+			err: pkgError.ValidationError("message: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCommunityAnnouncement(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateSetGroupMemberAddMode(t *testing.T) {
+	type args struct {
+		request domainGroup.SetGroupMemberAddModeRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with admin only mode",
+			args: args{request: domainGroup.SetGroupMemberAddModeRequest{
+				GroupID: "120363024512399999@g.us",
+				Mode:    "admin_add",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with everyone mode",
+			args: args{request: domainGroup.SetGroupMemberAddModeRequest{
+				GroupID: "120363024512399999@g.us",
+				Mode:    "all_member_add",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with unknown mode",
+			args: args{request: domainGroup.SetGroupMemberAddModeRequest{
+				GroupID: "120363024512399999@g.us",
+				Mode:    "everyone",
+			}},
+			err: pkgError.ValidationError("mode: must be one of admin_add or all_member_add."),
+		},
+		{
+			name: "should error with empty group id",
+			args: args{request: domainGroup.SetGroupMemberAddModeRequest{
+				Mode: "admin_add",
+			}},
+			err: pkgError.ValidationError("group_id: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetGroupMemberAddMode(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateSetGroupEphemeral(t *testing.T) {
+	type args struct {
+		request domainGroup.SetGroupEphemeralRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with 7 days timer",
+			args: args{request: domainGroup.SetGroupEphemeralRequest{
+				GroupID: "120363024512399999@g.us",
+				Timer:   "7d",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success turning timer off",
+			args: args{request: domainGroup.SetGroupEphemeralRequest{
+				GroupID: "120363024512399999@g.us",
+				Timer:   "off",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with unsupported timer",
+			args: args{request: domainGroup.SetGroupEphemeralRequest{
+				GroupID: "120363024512399999@g.us",
+				Timer:   "3d",
+			}},
+			err: pkgError.ValidationError("timer: must be one of off, 24h, 7d or 90d."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetGroupEphemeral(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateSetGroupJoinApproval(t *testing.T) {
+	assert.NoError(t, ValidateSetGroupJoinApproval(context.Background(), domainGroup.SetGroupJoinApprovalRequest{
+		GroupID:         "120363024512399999@g.us",
+		RequireApproval: true,
+	}))
+	assert.Equal(t, pkgError.ValidationError("group_id: cannot be blank."),
+		ValidateSetGroupJoinApproval(context.Background(), domainGroup.SetGroupJoinApprovalRequest{}))
+}
+
+func TestValidateRevokeGroupInviteLink(t *testing.T) {
+	assert.NoError(t, ValidateRevokeGroupInviteLink(context.Background(), domainGroup.RevokeGroupInviteLinkRequest{
+		GroupID: "120363024512399999@g.us",
+	}))
+	assert.Equal(t, pkgError.ValidationError("group_id: cannot be blank."),
+		ValidateRevokeGroupInviteLink(context.Background(), domainGroup.RevokeGroupInviteLinkRequest{}))
+}
+
+func TestValidateGroupMembership(t *testing.T) {
+	type args struct {
+		request domainGroup.GroupMembershipRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success without point in time",
+			args: args{request: domainGroup.GroupMembershipRequest{
+				GroupID: "123456789@g.us",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with RFC3339 point in time",
+			args: args{request: domainGroup.GroupMembershipRequest{
+				GroupID: "123456789@g.us",
+				At:      "2025-03-03T12:00:00Z",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty group id",
+			args: args{request: domainGroup.GroupMembershipRequest{}},
+			err:  pkgError.ValidationError("group_id: cannot be blank."),
+		},
+		{
+			name: "should error with invalid point in time",
+			args: args{request: domainGroup.GroupMembershipRequest{
+				GroupID: "123456789@g.us",
+				At:      "2025-03-03",
+			}},
+			err: pkgError.ValidationError("at: must be a valid date."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGroupMembership(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateGroupMembershipPeriod(t *testing.T) {
+	type args struct {
+		request domainGroup.GroupMembershipPeriodRequest
+	}
+	tests := []struct {
+		name     string
+		args     args
+		validate func(context.Context, domainGroup.GroupMembershipPeriodRequest) error
+		err      any
+	}{
+		{
+			name: "growth should success with period",
+			args: args{request: domainGroup.GroupMembershipPeriodRequest{
+				GroupID: "123456789@g.us",
+				From:    "2025-03-01T00:00:00Z",
+				To:      "2025-04-01T00:00:00Z",
+			}},
+			validate: ValidateGroupGrowth,
+			err:      nil,
+		},
+		{
+			name:     "growth should error with empty group id",
+			args:     args{request: domainGroup.GroupMembershipPeriodRequest{}},
+			validate: ValidateGroupGrowth,
+			err:      pkgError.ValidationError("group_id: cannot be blank."),
+		},
+		{
+			name:     "churn should success without group id",
+			args:     args{request: domainGroup.GroupMembershipPeriodRequest{}},
+			validate: ValidateGroupChurn,
+			err:      nil,
+		},
+		{
+			name: "churn should error with invalid from",
+			args: args{request: domainGroup.GroupMembershipPeriodRequest{
+				From: "yesterday",
+			}},
+			validate: ValidateGroupChurn,
+			err:      pkgError.ValidationError("from: must be a valid date."),
+		},
+		{
+			name: "churn should error when from is after to",
+			args: args{request: domainGroup.GroupMembershipPeriodRequest{
+				From: "2025-04-01T00:00:00Z",
+				To:   "2025-03-01T00:00:00Z",
+			}},
+			validate: ValidateGroupChurn,
+			err:      pkgError.ValidationError("from: must be before to."),
+		},
+		{
+			name: "growth should success with a period of 366 days",
+			args: args{request: domainGroup.GroupMembershipPeriodRequest{
+				GroupID: "123456789@g.us",
+				From:    "2024-01-01T00:00:00Z",
+				To:      "2025-01-01T00:00:00Z",
+			}},
+			validate: ValidateGroupGrowth,
+			err:      nil,
+		},
+		{
+			name: "growth should error with a period longer than 366 days",
+			args: args{request: domainGroup.GroupMembershipPeriodRequest{
+				GroupID: "123456789@g.us",
+				From:    "2024-01-01T00:00:00Z",
+				To:      "2025-01-02T00:00:00Z",
+			}},
+			validate: ValidateGroupGrowth,
+			err:      pkgError.ValidationError("from: the period must not be longer than 366 days."),
+		},
+		{
+			name: "churn should error with an ancient from and no to",
+			args: args{request: domainGroup.GroupMembershipPeriodRequest{
+				From: "0001-01-01T00:00:00Z",
+			}},
+			validate: ValidateGroupChurn,
+			err:      pkgError.ValidationError("from: the period must not be longer than 366 days."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateBulkAddParticipants(t *testing.T) {
+	type args struct {
+		request domainGroup.BulkAddParticipantsRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with CSV file",
+			args: args{request: domainGroup.BulkAddParticipantsRequest{
+				GroupID: "123456789@g.us",
+				File:    &multipart.FileHeader{Filename: "members.csv"},
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with participants and pacing",
+			args: args{request: domainGroup.BulkAddParticipantsRequest{
+				GroupID:      "123456789@g.us",
+				Participants: []string{"6281234567890"},
+				BatchSize:    10,
+				BatchDelay:   30,
+			}},
+			err: nil,
+		},
+		{
+			name: "should error without file and participants",
+			args: args{request: domainGroup.BulkAddParticipantsRequest{
+				GroupID: "123456789@g.us",
+			}},
+			err: pkgError.ValidationError("file: a CSV file or participants is required."),
+		},
+		{
+			name: "should error with batch size above limit",
+			args: args{request: domainGroup.BulkAddParticipantsRequest{
+				GroupID:      "123456789@g.us",
+				Participants: []string{"6281234567890"},
+				BatchSize:    100,
+			}},
+			err: pkgError.ValidationError("batch_size: must be no greater than 50."),
+		},
+		{
+			name: "should error with negative batch delay",
+			args: args{request: domainGroup.BulkAddParticipantsRequest{
+				GroupID:      "123456789@g.us",
+				Participants: []string{"6281234567890"},
+				BatchDelay:   -1,
+			}},
+			err: pkgError.ValidationError("batch_delay: must be no less than 0."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBulkAddParticipants(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateAcceptGroupInvite(t *testing.T) {
+	type args struct {
+		request domainGroup.AcceptGroupInviteRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with valid invite",
+			args: args{request: domainGroup.AcceptGroupInviteRequest{
+				GroupID:          "120363024512399999@g.us",
+				Inviter:          "6289685028129@s.whatsapp.net",
+				InviteCode:       "AbCdEfGhIjK",
+				InviteExpiration: time.Now().Add(24 * time.Hour).Unix(),
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with shared invite link",
+			args: args{request: domainGroup.AcceptGroupInviteRequest{
+				GroupID:    "120363024512399999@g.us",
+				Inviter:    "6289685028129@s.whatsapp.net",
+				InviteCode: "AbCdEfGhIjK",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty invite code",
+			args: args{request: domainGroup.AcceptGroupInviteRequest{
+				GroupID:          "120363024512399999@g.us",
+				Inviter:          "6289685028129@s.whatsapp.net",
+				InviteExpiration: time.Now().Add(24 * time.Hour).Unix(),
+			}},
+			err: pkgError.ValidationError("invite_code: cannot be blank."),
+		},
+		{
+			name: "should error with expired invite",
+			args: args{request: domainGroup.AcceptGroupInviteRequest{
+				GroupID:          "120363024512399999@g.us",
+				Inviter:          "6289685028129@s.whatsapp.net",
+				InviteCode:       "AbCdEfGhIjK",
+				InviteExpiration: time.Now().Add(-time.Hour).Unix(),
+			}},
+			err: pkgError.ValidationError("invite_expiration: the invite has expired."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAcceptGroupInvite(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -53,7 +53,6 @@ func ValidateReactMessage(ctx context.Context, request domainMessage.ReactionReq
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
 		validation.Field(&request.MessageID, validation.Required),
-		validation.Field(&request.Emoji, validation.Required),
 	)
 
 	if err != nil {
@@ -90,6 +89,22 @@ func ValidateStarMessage(ctx context.Context, request domainMessage.StarRequest)
 	return nil
 }
 
+func ValidatePinMessage(ctx context.Context, request domainMessage.PinMessageRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.MessageID, validation.Required),
+		validation.Field(&request.Duration, validation.When(request.IsPinned,
+			validation.In("24h", "7d", "30d").Error("must be one of 24h, 7d or 30d"),
+		)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
 func ValidateDownloadMedia(ctx context.Context, request domainMessage.DownloadMediaRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
@@ -102,3 +117,27 @@ func ValidateDownloadMedia(ctx context.Context, request domainMessage.DownloadMe
 
 	return nil
 }
+
+func ValidatePollResults(ctx context.Context, request domainMessage.PollResultsRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.MessageID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateMessageReceipts(ctx context.Context, request domainMessage.MessageReceiptsRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.MessageID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
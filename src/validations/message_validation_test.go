@@ -122,13 +122,13 @@ func TestValidateReactMessage(t *testing.T) {
 			err: pkgError.ValidationError("message_id: cannot be blank."),
 		},
 		{
-			name: "should error with empty emoji",
+			name: "should success with empty emoji to remove the reaction",
 			args: args{request: domainMessage.ReactionRequest{
 				Phone:     "6281234567890@s.whatsapp.net",
 				MessageID: "3EB0789ABC123456",
 				Emoji:     "",
 			}},
-			err: pkgError.ValidationError("emoji: cannot be blank."),
+			err: nil,
 		},
 		{
 			name: "should error with all empty fields",
@@ -137,7 +137,7 @@ func TestValidateReactMessage(t *testing.T) {
 				MessageID: "",
 				Emoji:     "",
 			}},
-			err: pkgError.ValidationError("emoji: cannot be blank; message_id: cannot be blank; phone: cannot be blank."),
+			err: pkgError.ValidationError("message_id: cannot be blank; phone: cannot be blank."),
 		},
 	}
 
@@ -150,7 +150,6 @@ func TestValidateReactMessage(t *testing.T) {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), "phone: cannot be blank")
 				assert.Contains(t, err.Error(), "message_id: cannot be blank")
-				assert.Contains(t, err.Error(), "emoji: cannot be blank")
 			} else {
 				assert.Equal(t, tt.err, err)
 			}
@@ -291,3 +290,114 @@ func TestValidateStarMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePollResults(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     domainMessage.PollResultsRequest
+		errContains []string
+	}{
+		{
+			name:        "should success with valid message id",
+			request:     domainMessage.PollResultsRequest{MessageID: "3EB0789ABC123456"},
+			errContains: nil,
+		},
+		{
+			name:        "should error with empty message id",
+			request:     domainMessage.PollResultsRequest{MessageID: ""},
+			errContains: []string{"message_id: cannot be blank"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePollResults(context.Background(), tt.request)
+			if len(tt.errContains) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				for _, msg := range tt.errContains {
+					assert.ErrorContains(t, err, msg)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePinMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     domainMessage.PinMessageRequest
+		errContains []string
+	}{
+		{
+			name:    "should success pinning with a duration",
+			request: domainMessage.PinMessageRequest{Phone: "628123456789", MessageID: "3EB0789ABC123456", Duration: "24h", IsPinned: true},
+		},
+		{
+			name:    "should success pinning without a duration",
+			request: domainMessage.PinMessageRequest{Phone: "628123456789", MessageID: "3EB0789ABC123456", IsPinned: true},
+		},
+		{
+			name:    "should success unpinning without a duration",
+			request: domainMessage.PinMessageRequest{Phone: "628123456789", MessageID: "3EB0789ABC123456"},
+		},
+		{
+			name:        "should error with unsupported duration",
+			request:     domainMessage.PinMessageRequest{Phone: "628123456789", MessageID: "3EB0789ABC123456", Duration: "90d", IsPinned: true},
+			errContains: []string{"duration: must be one of 24h, 7d or 30d"},
+		},
+		{
+			name:        "should error with empty phone and message id",
+			request:     domainMessage.PinMessageRequest{IsPinned: true},
+			errContains: []string{"phone: cannot be blank", "message_id: cannot be blank"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePinMessage(context.Background(), tt.request)
+			if len(tt.errContains) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				for _, msg := range tt.errContains {
+					assert.ErrorContains(t, err, msg)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMessageReceipts(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     domainMessage.MessageReceiptsRequest
+		errContains []string
+	}{
+		{
+			name:        "should success with valid message id",
+			request:     domainMessage.MessageReceiptsRequest{MessageID: "3EB0789ABC123456"},
+			errContains: nil,
+		},
+		{
+			name:        "should error with empty message id",
+			request:     domainMessage.MessageReceiptsRequest{MessageID: ""},
+			errContains: []string{"message_id: cannot be blank"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMessageReceipts(context.Background(), tt.request)
+			if len(tt.errContains) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				for _, msg := range tt.errContains {
+					assert.ErrorContains(t, err, msg)
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,13 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
+// newsletterPictureMimes are the picture formats accepted for newsletters
+var newsletterPictureMimes = map[string]bool{
+	"image/jpeg": true,
+	"image/jpg":  true,
+	"image/png":  true,
+}
+
 func ValidateUnfollowNewsletter(ctx context.Context, request domainNewsletter.UnfollowRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.NewsletterID, validation.Required),
@@ -18,3 +25,112 @@ func ValidateUnfollowNewsletter(ctx context.Context, request domainNewsletter.Un
 
 	return nil
 }
+
+func ValidateCreateNewsletter(ctx context.Context, request domainNewsletter.CreateNewsletterRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Name, validation.Required, validation.RuneLength(1, 100)),
+		validation.Field(&request.Description, validation.RuneLength(0, 2048)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if request.Picture != nil && !newsletterPictureMimes[request.Picture.Header.Get("Content-Type")] {
+		return pkgError.ValidationError("your picture is not allowed. please use jpg/jpeg/png")
+	}
+
+	return nil
+}
+
+func ValidateUpdateNewsletter(ctx context.Context, request domainNewsletter.UpdateNewsletterRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.NewsletterID, validation.Required),
+		validation.Field(&request.Name, validation.NilOrNotEmpty, validation.RuneLength(1, 100)),
+		validation.Field(&request.Description, validation.RuneLength(0, 2048)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if request.Name == nil && request.Description == nil && request.Picture == nil {
+		return pkgError.ValidationError("at least one of name, description or picture must be provided")
+	}
+
+	if request.Picture != nil && !newsletterPictureMimes[request.Picture.Header.Get("Content-Type")] {
+		return pkgError.ValidationError("your picture is not allowed. please use jpg/jpeg/png")
+	}
+
+	return nil
+}
+
+func ValidateFollowNewsletter(ctx context.Context, request domainNewsletter.FollowNewsletterRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Link, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateMuteNewsletter(ctx context.Context, request domainNewsletter.MuteNewsletterRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.NewsletterID, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateGetNewsletterInfo(ctx context.Context, request domainNewsletter.GetNewsletterInfoRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Link, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidatePostNewsletter(ctx context.Context, request domainNewsletter.PostNewsletterRequest) error {
+	hasImage := request.Image != nil || (request.ImageURL != nil && *request.ImageURL != "")
+	hasVideo := request.Video != nil || (request.VideoURL != nil && *request.VideoURL != "")
+
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.NewsletterID, validation.Required),
+		validation.Field(&request.Message, validation.When(!hasImage && !hasVideo, validation.Required)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if hasImage && hasVideo {
+		return pkgError.ValidationError("a post can contain either an image or a video, not both")
+	}
+
+	return nil
+}
+
+func ValidateGetNewsletterMessages(ctx context.Context, request domainNewsletter.GetNewsletterMessagesRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.NewsletterID, validation.Required),
+		validation.Field(&request.Limit, validation.Min(0), validation.Max(100)),
+		validation.Field(&request.Before, validation.Min(int64(0))),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
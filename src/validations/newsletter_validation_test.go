@@ -2,6 +2,7 @@ package validations
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	domainNewsletter "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/newsletter"
@@ -48,3 +49,166 @@ func TestValidateUnfollowNewsletter(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCreateNewsletter(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainNewsletter.CreateNewsletterRequest
+		err     any
+	}{
+		{
+			name:    "should success with name and description",
+			request: domainNewsletter.CreateNewsletterRequest{Name: "Store Updates", Description: "Weekly promos"},
+			err:     nil,
+		},
+		{
+			name:    "should error with empty name",
+			request: domainNewsletter.CreateNewsletterRequest{Description: "Weekly promos"},
+			err:     pkgError.ValidationError("name: cannot be blank."),
+		},
+		{
+			name:    "should error with too long name",
+			request: domainNewsletter.CreateNewsletterRequest{Name: strings.Repeat("a", 101)},
+			err:     pkgError.ValidationError("name: the length must be between 1 and 100."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateNewsletter(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateUpdateNewsletter(t *testing.T) {
+	name := "New Name"
+	empty := ""
+	tests := []struct {
+		name    string
+		request domainNewsletter.UpdateNewsletterRequest
+		err     any
+	}{
+		{
+			name:    "should success updating the name",
+			request: domainNewsletter.UpdateNewsletterRequest{NewsletterID: "120363123456789@newsletter", Name: &name},
+			err:     nil,
+		},
+		{
+			name:    "should success clearing the description",
+			request: domainNewsletter.UpdateNewsletterRequest{NewsletterID: "120363123456789@newsletter", Description: &empty},
+			err:     nil,
+		},
+		{
+			name:    "should error without any change",
+			request: domainNewsletter.UpdateNewsletterRequest{NewsletterID: "120363123456789@newsletter"},
+			err:     pkgError.ValidationError("at least one of name, description or picture must be provided"),
+		},
+		{
+			name:    "should error with empty name",
+			request: domainNewsletter.UpdateNewsletterRequest{NewsletterID: "120363123456789@newsletter", Name: &empty},
+			err:     pkgError.ValidationError("name: cannot be blank."),
+		},
+		{
+			name:    "should error with empty newsletter id",
+			request: domainNewsletter.UpdateNewsletterRequest{Name: &name},
+			err:     pkgError.ValidationError("newsletter_id: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpdateNewsletter(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateFollowNewsletter(t *testing.T) {
+	assert.NoError(t, ValidateFollowNewsletter(context.Background(), domainNewsletter.FollowNewsletterRequest{Link: "https://whatsapp.com/channel/0029VaZ4Ba3JENy2GQ4Xeq1t"}))
+	assert.Equal(t, pkgError.ValidationError("link: cannot be blank."), ValidateFollowNewsletter(context.Background(), domainNewsletter.FollowNewsletterRequest{}))
+}
+
+func TestValidateGetNewsletterInfo(t *testing.T) {
+	assert.NoError(t, ValidateGetNewsletterInfo(context.Background(), domainNewsletter.GetNewsletterInfoRequest{Link: "0029VaZ4Ba3JENy2GQ4Xeq1t"}))
+	assert.Equal(t, pkgError.ValidationError("link: cannot be blank."), ValidateGetNewsletterInfo(context.Background(), domainNewsletter.GetNewsletterInfoRequest{}))
+}
+
+func TestValidateMuteNewsletter(t *testing.T) {
+	assert.NoError(t, ValidateMuteNewsletter(context.Background(), domainNewsletter.MuteNewsletterRequest{NewsletterID: "120363123456789@newsletter", Mute: true}))
+	assert.Equal(t, pkgError.ValidationError("newsletter_id: cannot be blank."), ValidateMuteNewsletter(context.Background(), domainNewsletter.MuteNewsletterRequest{}))
+}
+
+func TestValidatePostNewsletter(t *testing.T) {
+	imageURL := "https://example.com/promo.jpg"
+	videoURL := "https://example.com/promo.mp4"
+	tests := []struct {
+		name    string
+		request domainNewsletter.PostNewsletterRequest
+		err     any
+	}{
+		{
+			name:    "should success with text",
+			request: domainNewsletter.PostNewsletterRequest{NewsletterID: "120363123456789@newsletter", Message: "New arrivals today"},
+			err:     nil,
+		},
+		{
+			name:    "should success with image url and no caption",
+			request: domainNewsletter.PostNewsletterRequest{NewsletterID: "120363123456789@newsletter", ImageURL: &imageURL},
+			err:     nil,
+		},
+		{
+			name:    "should error without text and media",
+			request: domainNewsletter.PostNewsletterRequest{NewsletterID: "120363123456789@newsletter"},
+			err:     pkgError.ValidationError("message: cannot be blank."),
+		},
+		{
+			name:    "should error with image and video",
+			request: domainNewsletter.PostNewsletterRequest{NewsletterID: "120363123456789@newsletter", ImageURL: &imageURL, VideoURL: &videoURL},
+			err:     pkgError.ValidationError("a post can contain either an image or a video, not both"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePostNewsletter(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateGetNewsletterMessages(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainNewsletter.GetNewsletterMessagesRequest
+		err     any
+	}{
+		{
+			name:    "should success with defaults",
+			request: domainNewsletter.GetNewsletterMessagesRequest{NewsletterID: "120363123456789@newsletter"},
+			err:     nil,
+		},
+		{
+			name:    "should success paging before a server id",
+			request: domainNewsletter.GetNewsletterMessagesRequest{NewsletterID: "120363123456789@newsletter", Limit: 20, Before: 150},
+			err:     nil,
+		},
+		{
+			name:    "should error with too large limit",
+			request: domainNewsletter.GetNewsletterMessagesRequest{NewsletterID: "120363123456789@newsletter", Limit: 101},
+			err:     pkgError.ValidationError("limit: must be no greater than 100."),
+		},
+		{
+			name:    "should error with empty newsletter id",
+			request: domainNewsletter.GetNewsletterMessagesRequest{},
+			err:     pkgError.ValidationError("newsletter_id: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGetNewsletterMessages(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
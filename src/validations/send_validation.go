@@ -8,6 +8,7 @@ import (
 	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
 	domainSend "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/send"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
 	"github.com/dustin/go-humanize"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -29,29 +30,19 @@ func validateDuration(dur *int) error {
 	return nil
 }
 
-// validatePhoneNumber validates that the phone number is in international format (not starting with 0)
+// validatePhoneNumber validates that the phone number can be normalized to E.164, JIDs and group IDs are accepted as they are
 func validatePhoneNumber(phone string) error {
 	if phone == "" {
 		return pkgError.ValidationError("phone number cannot be empty")
 	}
 
-	// Remove + prefix if present for validation
-	phoneNumber := phone
-	if len(phoneNumber) > 0 && phoneNumber[0] == '+' {
-		phoneNumber = phoneNumber[1:]
-	}
-
-	// Check if phone number starts with 0 (indicating local format)
-	if len(phoneNumber) > 0 && phoneNumber[0] == '0' {
-		return pkgError.ValidationError("phone number must be in international format (should not start with 0). For Indonesian numbers, use 62xxx format instead of 08xxx")
-	}
-
-	return nil
+	return utils.SanitizePhone(&phone)
 }
 
 func ValidateSendMessage(ctx context.Context, request domainSend.MessageRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.Format, validation.In(utils.MessageFormatMarkdown)),
 		validation.Field(&request.Message, validation.Required),
 	)
 
@@ -74,6 +65,7 @@ func ValidateSendMessage(ctx context.Context, request domainSend.MessageRequest)
 func ValidateSendImage(ctx context.Context, request domainSend.ImageRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.Format, validation.In(utils.MessageFormatMarkdown)),
 	)
 
 	if err != nil {
@@ -123,7 +115,7 @@ func ValidateSendImage(ctx context.Context, request domainSend.ImageRequest) err
 func ValidateSendFile(ctx context.Context, request domainSend.FileRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
-		validation.Field(&request.File, validation.Required),
+		validation.Field(&request.Format, validation.In(utils.MessageFormatMarkdown)),
 	)
 
 	if err != nil {
@@ -135,7 +127,18 @@ func ValidateSendFile(ctx context.Context, request domainSend.FileRequest) error
 		return err
 	}
 
-	if request.File.Size > config.WhatsappSettingMaxFileSize { // 10MB
+	// Ensure at least one of File or FileURL is provided
+	if request.File == nil && (request.FileURL == nil || *request.FileURL == "") {
+		return pkgError.ValidationError("either File or FileURL must be provided")
+	}
+
+	if request.FileURL != nil && *request.FileURL != "" {
+		if err := validation.Validate(*request.FileURL, is.URL); err != nil {
+			return pkgError.ValidationError("FileURL must be a valid URL")
+		}
+	}
+
+	if request.File != nil && request.File.Size > config.WhatsappSettingMaxFileSize { // 10MB
 		maxSizeString := humanize.Bytes(uint64(config.WhatsappSettingMaxFileSize))
 		return pkgError.ValidationError(fmt.Sprintf("max file upload is %s, please upload in cloud and send via text if your file is higher than %s", maxSizeString, maxSizeString))
 	}
@@ -151,6 +154,7 @@ func ValidateSendVideo(ctx context.Context, request domainSend.VideoRequest) err
 	// Validate common required fields
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.Format, validation.In(utils.MessageFormatMarkdown)),
 	)
 
 	if err != nil {
@@ -232,9 +236,36 @@ func ValidateSendContact(ctx context.Context, request domainSend.ContactRequest)
 	return nil
 }
 
+// maxGroupInviteExpiration bounds how long a group invite card stays valid
+const maxGroupInviteExpiration = 30 * 24 * 60 * 60
+
+func ValidateSendGroupInvite(ctx context.Context, request domainSend.GroupInviteRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.GroupID, validation.Required),
+		validation.Field(&request.Caption, validation.RuneLength(0, 1024)),
+		validation.Field(&request.Expiration, validation.Min(0), validation.Max(maxGroupInviteExpiration)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if err := validatePhoneNumber(request.Phone); err != nil {
+		return err
+	}
+
+	if err := validateDuration(request.Duration); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func ValidateSendLink(ctx context.Context, request domainSend.LinkRequest) error {
 	err := validation.ValidateStructWithContext(ctx, &request,
 		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.Format, validation.In(utils.MessageFormatMarkdown)),
 		validation.Field(&request.Link, validation.Required, is.URL),
 		validation.Field(&request.Caption, validation.Required),
 	)
@@ -50,6 +50,28 @@ func TestValidateSendMessage(t *testing.T) {
 			}},
 			err: pkgError.ValidationError("message: cannot be blank."),
 		},
+		{
+			name: "should success with markdown format",
+			args: args{request: domainSend.MessageRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "1728937129312@s.whatsapp.net",
+				},
+				Message: "**Hello** this is testing",
+				Format:  "markdown",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with unsupported format",
+			args: args{request: domainSend.MessageRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "1728937129312@s.whatsapp.net",
+				},
+				Message: "<b>Hello</b>",
+				Format:  "html",
+			}},
+			err: pkgError.ValidationError("format: must be a valid value."),
+		},
 	}
 
 	for _, tt := range tests {
@@ -173,7 +195,27 @@ func TestValidateSendFile(t *testing.T) {
 				},
 				File: nil,
 			}},
-			err: pkgError.ValidationError("file: cannot be blank."),
+			err: pkgError.ValidationError("either File or FileURL must be provided"),
+		},
+		{
+			name: "should success with file url",
+			args: args{request: domainSend.FileRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "1728937129312@s.whatsapp.net",
+				},
+				FileURL: func() *string { s := "https://example.com/invoice.pdf"; return &s }(),
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with invalid file url",
+			args: args{request: domainSend.FileRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "1728937129312@s.whatsapp.net",
+				},
+				FileURL: func() *string { s := "invalid-url"; return &s }(),
+			}},
+			err: pkgError.ValidationError("FileURL must be a valid URL"),
 		},
 	}
 
@@ -531,6 +573,64 @@ func TestValidateSendContact(t *testing.T) {
 	}
 }
 
+func TestValidateSendGroupInvite(t *testing.T) {
+	type args struct {
+		request domainSend.GroupInviteRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success normal condition",
+			args: args{request: domainSend.GroupInviteRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "6289685028129@s.whatsapp.net",
+				},
+				GroupID:    "120363024512399999@g.us",
+				Caption:    "Join our group",
+				Expiration: 86400,
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty group id",
+			args: args{request: domainSend.GroupInviteRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "6289685028129@s.whatsapp.net",
+				},
+			}},
+			err: pkgError.ValidationError("group_id: cannot be blank."),
+		},
+		{
+			name: "should error with empty phone",
+			args: args{request: domainSend.GroupInviteRequest{
+				GroupID: "120363024512399999@g.us",
+			}},
+			err: pkgError.ValidationError("phone: cannot be blank."),
+		},
+		{
+			name: "should error with expiration above 30 days",
+			args: args{request: domainSend.GroupInviteRequest{
+				BaseRequest: domainSend.BaseRequest{
+					Phone: "6289685028129@s.whatsapp.net",
+				},
+				GroupID:    "120363024512399999@g.us",
+				Expiration: 31 * 24 * 60 * 60,
+			}},
+			err: pkgError.ValidationError("expiration: must be no greater than 2592000."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSendGroupInvite(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
 func TestValidateSendLocation(t *testing.T) {
 	type args struct {
 		request domainSend.LocationRequest
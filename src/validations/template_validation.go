@@ -0,0 +1,109 @@
+package validations
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	domainSend "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/send"
+	domainTemplate "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/template"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+// templateNamePattern keeps template names safe to use as URL path segments
+var templateNamePattern = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+
+// validTemplateBody checks that a template body parses with the message template function set
+var validTemplateBody = validation.By(func(value any) error {
+	body, _ := value.(string)
+	if _, err := utils.ParseMessageTemplate(body); err != nil {
+		return errors.New("must be a valid template: " + err.Error())
+	}
+	return nil
+})
+
+func templateNameRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Required,
+		validation.Length(1, 64),
+		validation.Match(templateNamePattern).Error("must only contain lowercase letters, digits, '_', '.' or '-'"),
+	}
+}
+
+func ValidateSaveTemplate(ctx context.Context, request domainTemplate.SaveTemplateRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Name, templateNameRules()...),
+		validation.Field(&request.Body, validation.Required, validTemplateBody),
+		validation.Field(&request.MediaType, validation.In("image", "video", "document", "audio")),
+		validation.Field(&request.MediaURL,
+			validation.When(request.MediaType != "", validation.Required),
+			validation.When(request.MediaType == "", validation.Empty.Error("requires media_type")),
+		),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	// The media URL may contain variables, so only check the URL shape when it is static
+	if request.MediaURL != "" {
+		variables, err := utils.TemplateVariables(request.MediaURL)
+		if err != nil {
+			return pkgError.ValidationError("media_url: must be a valid template: " + err.Error())
+		}
+		if len(variables) == 0 {
+			if err := validation.Validate(request.MediaURL, is.URL); err != nil {
+				return pkgError.ValidationError("media_url: " + err.Error() + ".")
+			}
+		}
+	}
+
+	return nil
+}
+
+func ValidateGetTemplate(ctx context.Context, request domainTemplate.GetTemplateRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Name, validation.Required),
+		validation.Field(&request.Version, validation.Min(0)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidatePreviewTemplate(ctx context.Context, request domainTemplate.PreviewTemplateRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Name, validation.Required),
+		validation.Field(&request.Version, validation.Min(0)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateSendTemplate(ctx context.Context, request domainSend.TemplateRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Phone, validation.Required),
+		validation.Field(&request.TemplateName, validation.Required),
+		validation.Field(&request.Version, validation.Min(0)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if err := validateDuration(request.Duration); err != nil {
+		return err
+	}
+
+	return nil
+}
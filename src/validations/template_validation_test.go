@@ -0,0 +1,234 @@
+package validations
+
+import (
+	"context"
+	"testing"
+
+	domainSend "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/send"
+	domainTemplate "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/template"
+	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSaveTemplate(t *testing.T) {
+	type args struct {
+		request domainTemplate.SaveTemplateRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with text template",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name: "order_ready",
+				Body: "Hi {{.name}}, your order {{.order_id}} is ready",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with image template using variable url",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:      "promo.v2",
+				Body:      "{{.headline}}",
+				MediaType: "image",
+				MediaURL:  "https://cdn.example.com/{{.image}}.jpg",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty name",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Body: "Hello",
+			}},
+			err: pkgError.ValidationError("name: cannot be blank."),
+		},
+		{
+			name: "should error with invalid name characters",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name: "Order Ready",
+				Body: "Hello",
+			}},
+			err: pkgError.ValidationError("name: must only contain lowercase letters, digits, '_', '.' or '-'."),
+		},
+		{
+			name: "should error with unparsable body",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name: "broken",
+				Body: "Hi {{.name",
+			}},
+			err: pkgError.ValidationError("body: must be a valid template: template: message:1: unclosed action."),
+		},
+		{
+			name: "should success with document media",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:      "doc",
+				Body:      "Invoice {{.invoice_id}}",
+				MediaType: "document",
+				MediaURL:  "https://example.com/{{.invoice_id}}.pdf",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with audio media",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:      "voice",
+				Body:      "Listen to this",
+				MediaType: "audio",
+				MediaURL:  "https://example.com/a.ogg",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with unsupported media type",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:      "sticker",
+				Body:      "Hi",
+				MediaType: "sticker",
+				MediaURL:  "https://example.com/a.webp",
+			}},
+			err: pkgError.ValidationError("media_type: must be a valid value."),
+		},
+		{
+			name: "should error with media type but no url",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:      "promo",
+				Body:      "Promo",
+				MediaType: "video",
+			}},
+			err: pkgError.ValidationError("media_url: cannot be blank."),
+		},
+		{
+			name: "should error with media url but no media type",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:     "promo",
+				Body:     "Promo",
+				MediaURL: "https://example.com/a.jpg",
+			}},
+			err: pkgError.ValidationError("media_url: requires media_type."),
+		},
+		{
+			name: "should error with invalid static media url",
+			args: args{request: domainTemplate.SaveTemplateRequest{
+				Name:      "promo",
+				Body:      "Promo",
+				MediaType: "image",
+				MediaURL:  "not a url",
+			}},
+			err: pkgError.ValidationError("media_url: must be a valid URL."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSaveTemplate(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateGetTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainTemplate.GetTemplateRequest
+		err     any
+	}{
+		{
+			name:    "should success with name only",
+			request: domainTemplate.GetTemplateRequest{Name: "order_ready"},
+			err:     nil,
+		},
+		{
+			name:    "should error with empty name",
+			request: domainTemplate.GetTemplateRequest{},
+			err:     pkgError.ValidationError("name: cannot be blank."),
+		},
+		{
+			name:    "should error with negative version",
+			request: domainTemplate.GetTemplateRequest{Name: "order_ready", Version: -1},
+			err:     pkgError.ValidationError("version: must be no less than 0."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGetTemplate(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidatePreviewTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domainTemplate.PreviewTemplateRequest
+		err     any
+	}{
+		{
+			name:    "should success with variables",
+			request: domainTemplate.PreviewTemplateRequest{Name: "order_ready", Variables: map[string]any{"name": "Budi"}},
+			err:     nil,
+		},
+		{
+			name:    "should error with empty name",
+			request: domainTemplate.PreviewTemplateRequest{},
+			err:     pkgError.ValidationError("name: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePreviewTemplate(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateSendTemplate(t *testing.T) {
+	negativeDuration := -1
+	tests := []struct {
+		name    string
+		request domainSend.TemplateRequest
+		err     any
+	}{
+		{
+			name: "should success with phone and template name",
+			request: domainSend.TemplateRequest{
+				BaseRequest:  domainSend.BaseRequest{Phone: "6281234567890"},
+				TemplateName: "order_ready",
+				Variables:    map[string]any{"name": "Budi"},
+			},
+			err: nil,
+		},
+		{
+			name: "should error with empty template name",
+			request: domainSend.TemplateRequest{
+				BaseRequest: domainSend.BaseRequest{Phone: "6281234567890"},
+			},
+			err: pkgError.ValidationError("template_name: cannot be blank."),
+		},
+		{
+			name: "should error with empty phone",
+			request: domainSend.TemplateRequest{
+				TemplateName: "order_ready",
+			},
+			err: pkgError.ValidationError("phone: cannot be blank."),
+		},
+		{
+			name: "should error with negative duration",
+			request: domainSend.TemplateRequest{
+				BaseRequest:  domainSend.BaseRequest{Phone: "6281234567890", Duration: &negativeDuration},
+				TemplateName: "order_ready",
+			},
+			err: pkgError.ValidationError("duration must be between 0 and 4294967295 seconds (0 means no expiry)"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSendTemplate(context.Background(), tt.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
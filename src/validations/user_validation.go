@@ -2,7 +2,10 @@ package validations
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/aldinokemal/go-whatsapp-web-multidevice/config"
 	domainUser "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/user"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -44,3 +47,108 @@ func ValidateBusinessProfile(ctx context.Context, request domainUser.BusinessPro
 
 	return nil
 }
+
+func ValidateBlockContact(ctx context.Context, request domainUser.BlockContactRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Phone, validation.Required),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateSubscribePresence(ctx context.Context, request domainUser.SubscribePresenceRequest) error {
+	return validatePresencePhone(ctx, request.Phone)
+}
+
+func ValidatePresence(ctx context.Context, request domainUser.PresenceRequest) error {
+	return validatePresencePhone(ctx, request.Phone)
+}
+
+// validatePresencePhone checks the contact of a presence request, groups have no presence
+func validatePresencePhone(ctx context.Context, phone string) error {
+	err := validation.ValidateWithContext(ctx, &phone, validation.Required)
+	if err != nil {
+		return pkgError.ValidationError(fmt.Sprintf("phone: %s.", err.Error()))
+	}
+
+	if strings.HasSuffix(phone, config.WhatsappTypeGroup) {
+		return pkgError.ValidationError("phone: presence is only available for contacts.")
+	}
+
+	return nil
+}
+
+func ValidateUpdatePrivacySetting(ctx context.Context, request domainUser.UpdatePrivacySettingRequest) error {
+	audience := []any{"all", "contacts", "contact_blacklist", "none"}
+
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.LastSeen, validation.In(audience...)),
+		validation.Field(&request.Online, validation.In("all", "match_last_seen")),
+		validation.Field(&request.Profile, validation.In(audience...)),
+		validation.Field(&request.About, validation.In(audience...)),
+		validation.Field(&request.ReadReceipts, validation.In("all", "none")),
+		validation.Field(&request.GroupAdd, validation.In(audience...)),
+		validation.Field(&request.CallAdd, validation.In("all", "known")),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if request == (domainUser.UpdatePrivacySettingRequest{}) {
+		return pkgError.ValidationError("at least one privacy setting must be provided")
+	}
+
+	return nil
+}
+
+func ValidateChangeAbout(ctx context.Context, request domainUser.ChangeAboutRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.About, validation.Required, validation.RuneLength(1, 139)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	return nil
+}
+
+func ValidateApplyProfile(ctx context.Context, profile domainUser.DeclarativeProfile) error {
+	if profile.PushName == "" && profile.About == nil && profile.Privacy == nil {
+		return pkgError.ValidationError("profile is empty, set at least one of push_name, about or privacy")
+	}
+
+	if profile.About != nil {
+		if err := ValidateChangeAbout(ctx, domainUser.ChangeAboutRequest{About: *profile.About}); err != nil {
+			return err
+		}
+	}
+	if profile.Privacy != nil {
+		if err := ValidateUpdatePrivacySetting(ctx, *profile.Privacy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateBulkCheck(ctx context.Context, request domainUser.BulkCheckRequest) error {
+	err := validation.ValidateStructWithContext(ctx, &request,
+		validation.Field(&request.Phones, validation.Each(validation.Required)),
+	)
+
+	if err != nil {
+		return pkgError.ValidationError(err.Error())
+	}
+
+	if request.File == nil && len(request.Phones) == 0 {
+		return pkgError.ValidationError("file: a CSV file or phones is required.")
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	domainUser "github.com/aldinokemal/go-whatsapp-web-multidevice/domains/user"
 	pkgError "github.com/aldinokemal/go-whatsapp-web-multidevice/pkg/error"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
@@ -110,3 +111,213 @@ func TestValidateBusinessProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBlockContact(t *testing.T) {
+	type args struct {
+		request domainUser.BlockContactRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with valid phone",
+			args: args{request: domainUser.BlockContactRequest{
+				Phone: "1728937129312@s.whatsapp.net",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty phone",
+			args: args{request: domainUser.BlockContactRequest{
+				Phone: "",
+			}},
+			err: pkgError.ValidationError("phone: cannot be blank."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBlockContact(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateSubscribePresence(t *testing.T) {
+	type args struct {
+		request domainUser.SubscribePresenceRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with valid phone",
+			args: args{request: domainUser.SubscribePresenceRequest{
+				Phone: "6281234567890@s.whatsapp.net",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with empty phone",
+			args: args{request: domainUser.SubscribePresenceRequest{
+				Phone: "",
+			}},
+			err: pkgError.ValidationError("phone: cannot be blank."),
+		},
+		{
+			name: "should error with group",
+			args: args{request: domainUser.SubscribePresenceRequest{
+				Phone: "120363024512399999@g.us",
+			}},
+			err: pkgError.ValidationError("phone: presence is only available for contacts."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSubscribePresence(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateUpdatePrivacySetting(t *testing.T) {
+	type args struct {
+		request domainUser.UpdatePrivacySettingRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with a full provisioning profile",
+			args: args{request: domainUser.UpdatePrivacySettingRequest{
+				LastSeen:     "contacts",
+				Online:       "match_last_seen",
+				Profile:      "contacts",
+				About:        "contact_blacklist",
+				ReadReceipts: "none",
+				GroupAdd:     "contacts",
+				CallAdd:      "known",
+			}},
+			err: nil,
+		},
+		{
+			name: "should success with a single setting",
+			args: args{request: domainUser.UpdatePrivacySettingRequest{
+				ReadReceipts: "all",
+			}},
+			err: nil,
+		},
+		{
+			name: "should error with no settings",
+			args: args{request: domainUser.UpdatePrivacySettingRequest{}},
+			err:  pkgError.ValidationError("at least one privacy setting must be provided"),
+		},
+		{
+			name: "should error with value not allowed for online",
+			args: args{request: domainUser.UpdatePrivacySettingRequest{
+				Online: "contacts",
+			}},
+			err: pkgError.ValidationError("online: must be a valid value."),
+		},
+		{
+			name: "should error with value not allowed for read receipts",
+			args: args{request: domainUser.UpdatePrivacySettingRequest{
+				ReadReceipts: "contacts",
+			}},
+			err: pkgError.ValidationError("read_receipts: must be a valid value."),
+		},
+		{
+			name: "should error with value not allowed for call add",
+			args: args{request: domainUser.UpdatePrivacySettingRequest{
+				CallAdd: "none",
+			}},
+			err: pkgError.ValidationError("call_add: must be a valid value."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpdatePrivacySetting(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateChangeAbout(t *testing.T) {
+	type args struct {
+		request domainUser.ChangeAboutRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success",
+			args: args{request: domainUser.ChangeAboutRequest{About: "Available"}},
+			err:  nil,
+		},
+		{
+			name: "should error with empty about",
+			args: args{request: domainUser.ChangeAboutRequest{About: ""}},
+			err:  pkgError.ValidationError("about: cannot be blank."),
+		},
+		{
+			name: "should error with about too long",
+			args: args{request: domainUser.ChangeAboutRequest{About: strings.Repeat("a", 140)}},
+			err:  pkgError.ValidationError("about: the length must be between 1 and 139."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChangeAbout(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestValidateBulkCheck(t *testing.T) {
+	type args struct {
+		request domainUser.BulkCheckRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		err  any
+	}{
+		{
+			name: "should success with phones",
+			args: args{request: domainUser.BulkCheckRequest{
+				Phones: []string{"6281234567890", "6289876543210"},
+			}},
+			err: nil,
+		},
+		{
+			name: "should error without phones or file",
+			args: args{request: domainUser.BulkCheckRequest{}},
+			err:  pkgError.ValidationError("file: a CSV file or phones is required."),
+		},
+		{
+			name: "should error with blank phone",
+			args: args{request: domainUser.BulkCheckRequest{
+				Phones: []string{"6281234567890", ""},
+			}},
+			err: pkgError.ValidationError("phones: (1: cannot be blank.)."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBulkCheck(context.Background(), tt.args.request)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
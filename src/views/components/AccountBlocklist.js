@@ -0,0 +1,135 @@
+export default {
+    name: 'AccountBlocklist',
+    data() {
+        return {
+            loading: false,
+            phone: '',
+            blocked: [],
+        }
+    },
+    methods: {
+        async openModal() {
+            try {
+                await this.fetchBlocklist();
+                $('#modalAccountBlocklist').modal('show');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        isValidForm() {
+            return this.phone.trim() !== '';
+        },
+        getPhoneNumber(jid) {
+            return jid.split('@')[0];
+        },
+        async fetchBlocklist() {
+            try {
+                let response = await window.http.get(`/user/blocklist`)
+                this.blocked = response.data.results.data || [];
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            }
+        },
+        async handleBlock() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi('/user/block', this.phone)
+                this.phone = '';
+                showSuccessInfo(response)
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async handleUnblock(jid) {
+            if (this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi('/user/unblock', jid)
+                showSuccessInfo(response)
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi(url, phone) {
+            this.loading = true;
+            try {
+                let response = await window.http.post(url, {phone: phone})
+                this.blocked = response.data.results.data || [];
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+    },
+    template: `
+    <div class="olive card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui olive right ribbon label">Contacts</a>
+            <div class="header">Blocked Contacts</div>
+            <div class="description">
+                Block, unblock and list blocked contacts
+            </div>
+        </div>
+    </div>
+
+    <!--  Modal Blocklist  -->
+    <div class="ui small modal" id="modalAccountBlocklist">
+        <i class="close icon"></i>
+        <div class="header">
+            Blocked Contacts
+        </div>
+        <div class="scrolling content">
+            <form class="ui form" @submit.prevent="handleBlock">
+                <div class="field">
+                    <label>Phone</label>
+                    <div class="ui action input">
+                        <input v-model="phone" type="text" placeholder="6289..."
+                               aria-label="Phone to block">
+                        <button class="ui red button" type="submit"
+                                :class="{'loading': loading, 'disabled': !isValidForm() || loading}">
+                            <i class="ban icon"></i> Block
+                        </button>
+                    </div>
+                </div>
+            </form>
+
+            <table class="ui celled compact table">
+                <thead>
+                <tr>
+                    <th>Phone Number</th>
+                    <th>JID</th>
+                    <th></th>
+                </tr>
+                </thead>
+                <tbody>
+                <tr v-if="blocked.length === 0">
+                    <td colspan="3">No blocked contacts</td>
+                </tr>
+                <tr v-for="jid in blocked" :key="jid">
+                    <td>{{ getPhoneNumber(jid) }}</td>
+                    <td>{{ jid }}</td>
+                    <td class="collapsing">
+                        <button class="ui mini button" type="button"
+                                :class="{'disabled': loading}"
+                                @click="handleUnblock(jid)">
+                            Unblock
+                        </button>
+                    </td>
+                </tr>
+                </tbody>
+            </table>
+        </div>
+    </div>
+    `
+}
@@ -0,0 +1,91 @@
+export default {
+    name: 'AccountChangeAbout',
+    data() {
+        return {
+            loading: false,
+            about: ''
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalChangeAbout').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.about.trim() !== '' && this.about.length <= 139;
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalChangeAbout').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                let payload = {
+                    about: this.about
+                }
+
+                let response = await window.http.post(`/user/about`, payload)
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.about = '';
+        }
+    },
+    template: `
+    <div class="olive card" @click="openModal()" style="cursor:pointer;">
+        <div class="content">
+            <a class="ui olive right ribbon label">Account</a>
+            <div class="header">Change About</div>
+            <div class="description">
+                Update the about text on your profile
+            </div>
+        </div>
+    </div>
+
+    <!--  Modal Change About  -->
+    <div class="ui small modal" id="modalChangeAbout">
+        <i class="close icon"></i>
+        <div class="header">
+            Change About
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>About ({{ about.length }}/139)</label>
+                    <input type="text" v-model="about" maxlength="139" placeholder="Available">
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button"
+                 :class="{'loading': this.loading, 'disabled': !isValidForm() || loading}"
+                 @click.prevent="handleSubmit">
+                Update About
+                <i class="save icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -0,0 +1,158 @@
+export default {
+    name: 'AccountPresence',
+    data() {
+        return {
+            loading: false,
+            phone: '',
+            presence: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalAccountPresence').modal('show');
+        },
+        isValidForm() {
+            return this.phone.trim() !== '';
+        },
+        chatStateText(state) {
+            switch (state) {
+                case 'composing':
+                    return 'typing';
+                case 'recording':
+                    return 'recording audio';
+                default:
+                    return state;
+            }
+        },
+        async handleGet() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                await this.fetchPresence();
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async fetchPresence() {
+            this.loading = true;
+            try {
+                let response = await window.http.get(`/user/presence?phone=${encodeURIComponent(this.phone)}`)
+                this.presence = response.data.results;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        async handleSubscription(subscribe) {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi(subscribe ? '/user/presence/subscribe' : '/user/presence/unsubscribe')
+                showSuccessInfo(response)
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi(url) {
+            this.loading = true;
+            try {
+                let response = await window.http.post(url, {phone: this.phone})
+                this.presence = response.data.results;
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+    },
+    template: `
+    <div class="olive card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui olive right ribbon label">Contacts</a>
+            <div class="header">Contact Presence</div>
+            <div class="description">
+                See when a contact is online, last seen or typing
+            </div>
+        </div>
+    </div>
+
+    <!--  Modal Presence  -->
+    <div class="ui small modal" id="modalAccountPresence">
+        <i class="close icon"></i>
+        <div class="header">
+            Contact Presence
+        </div>
+        <div class="content">
+            <form class="ui form" @submit.prevent="handleGet">
+                <div class="field">
+                    <label>Phone</label>
+                    <input v-model="phone" type="text" placeholder="6289..."
+                           aria-label="Phone of the contact">
+                </div>
+                <button class="ui button" type="submit"
+                        :class="{'loading': loading, 'disabled': !isValidForm() || loading}">
+                    <i class="sync icon"></i> Refresh
+                </button>
+                <button class="ui green button" type="button"
+                        :class="{'disabled': !isValidForm() || loading}"
+                        @click="handleSubscription(true)">
+                    <i class="eye icon"></i> Subscribe
+                </button>
+                <button class="ui button" type="button"
+                        :class="{'disabled': !isValidForm() || loading}"
+                        @click="handleSubscription(false)">
+                    <i class="eye slash icon"></i> Unsubscribe
+                </button>
+            </form>
+
+            <table class="ui celled compact definition table" v-if="presence">
+                <tbody>
+                <tr>
+                    <td class="collapsing">JID</td>
+                    <td>{{ presence.jid }}</td>
+                </tr>
+                <tr>
+                    <td>Subscribed</td>
+                    <td>{{ presence.subscribed ? 'Yes' : 'No' }}</td>
+                </tr>
+                <tr>
+                    <td>Status</td>
+                    <td>
+                        <span class="ui mini label" :class="presence.is_online ? 'green' : 'grey'">
+                            {{ presence.is_online ? 'online' : 'offline' }}
+                        </span>
+                    </td>
+                </tr>
+                <tr>
+                    <td>Last Seen</td>
+                    <td>{{ presence.last_seen || '-' }}</td>
+                </tr>
+                <tr>
+                    <td>Chat State</td>
+                    <td>
+                        <span v-if="presence.chat_state">
+                            {{ chatStateText(presence.chat_state) }} in {{ presence.chat_state_chat }} at {{ presence.chat_state_at }}
+                        </span>
+                        <span v-else>-</span>
+                    </td>
+                </tr>
+                <tr>
+                    <td>Updated At</td>
+                    <td>{{ presence.updated_at || 'No update received yet' }}</td>
+                </tr>
+                </tbody>
+            </table>
+        </div>
+    </div>
+    `
+}
@@ -1,24 +1,97 @@
+const audienceOptions = [
+    {value: 'all', text: 'Everyone'},
+    {value: 'contacts', text: 'My contacts'},
+    {value: 'contact_blacklist', text: 'My contacts except...'},
+    {value: 'none', text: 'Nobody'},
+];
+
 export default {
     name: 'AccountPrivacy',
     data() {
         return {
-            data_privacy: null
+            loading: false,
+            data_privacy: null,
+            form: {
+                last_seen: '',
+                online: '',
+                profile: '',
+                about: '',
+                read_receipts: '',
+                group_add: '',
+                call_add: '',
+            },
+            settings: [
+                {key: 'last_seen', label: 'Who can see my Last Seen', options: audienceOptions},
+                {
+                    key: 'online', label: 'Who can see when I am Online', options: [
+                        {value: 'all', text: 'Everyone'},
+                        {value: 'match_last_seen', text: 'Same as Last Seen'},
+                    ]
+                },
+                {key: 'profile', label: 'Who can see my Profile Photo', options: audienceOptions},
+                {key: 'about', label: 'Who can see my About', options: audienceOptions},
+                {
+                    key: 'read_receipts', label: 'Read Receipts', options: [
+                        {value: 'all', text: 'On'},
+                        {value: 'none', text: 'Off'},
+                    ]
+                },
+                {key: 'group_add', label: 'Who can add me to Groups', options: audienceOptions},
+                {
+                    key: 'call_add', label: 'Who can Call me', options: [
+                        {value: 'all', text: 'Everyone'},
+                        {value: 'known', text: 'Known contacts'},
+                    ]
+                },
+            ],
         }
     },
     methods: {
         async openModal() {
             try {
-                await this.submitApi();
-                $('#modalUserPrivacy').modal('show');
+                await this.fetchApi();
+                $('#modalUserPrivacy').modal({
+                    onApprove: function () {
+                        return false;
+                    }
+                }).modal('show');
                 showSuccessInfo("Privacy fetched")
             } catch (err) {
                 showErrorInfo(err)
             }
         },
-        async submitApi() {
+        fillForm(privacy) {
+            this.data_privacy = privacy;
+            this.form = {
+                last_seen: privacy.last_seen,
+                online: privacy.online,
+                profile: privacy.profile,
+                about: privacy.about,
+                read_receipts: privacy.read_receipts,
+                group_add: privacy.group_add,
+                call_add: privacy.call_add,
+            };
+        },
+        changedSettings() {
+            if (this.data_privacy == null) {
+                return {};
+            }
+            const current = this.data_privacy;
+            const changed = {};
+            for (const key of Object.keys(this.form)) {
+                if (this.form[key] && this.form[key] !== current[key]) {
+                    changed[key] = this.form[key];
+                }
+            }
+            return changed;
+        },
+        isValidForm() {
+            return Object.keys(this.changedSettings()).length > 0;
+        },
+        async fetchApi() {
             try {
                 let response = await window.http.get(`/user/my/privacy`)
-                this.data_privacy = response.data.results;
+                this.fillForm(response.data.results);
             } catch (error) {
                 if (error.response) {
                     throw new Error(error.response.data.message);
@@ -26,6 +99,32 @@ export default {
                 throw new Error(error.message);
             }
         },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                let response = await window.http.post(`/user/my/privacy`, this.changedSettings())
+                this.fillForm(response.data.results);
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
     },
     template: `
     <div class="olive card" @click="openModal" style="cursor: pointer">
@@ -33,11 +132,11 @@ export default {
         <a class="ui olive right ribbon label">Account</a>
             <div class="header">My Privacy Setting</div>
             <div class="description">
-                Get your privacy settings
+                View and update your privacy settings
             </div>
         </div>
     </div>
-    
+
     <!--  Modal UserPrivacy  -->
     <div class="ui small modal" id="modalUserPrivacy">
         <i class="close icon"></i>
@@ -45,14 +144,23 @@ export default {
             My Privacy
         </div>
         <div class="content">
-            <ol v-if="data_privacy != null">
-                <li>Who can add Group : <b>{{ data_privacy.group_add }}</b></li>
-                <li>Who can see my Last Seen : <b>{{ data_privacy.last_seen }}</b></li>
-                <li>Who can see my Status : <b>{{ data_privacy.status }}</b></li>
-                <li>Who can see my Profile : <b>{{ data_privacy.profile }}</b></li>
-                <li>Read Receipts : <b>{{ data_privacy.read_receipts }}</b></li>
-            </ol>
+            <form class="ui form" v-if="data_privacy != null">
+                <div class="field" v-for="setting in settings" :key="setting.key">
+                    <label>{{ setting.label }}</label>
+                    <select class="ui dropdown" v-model="form[setting.key]" :aria-label="setting.label">
+                        <option v-for="option in setting.options" :value="option.value">{{ option.text }}</option>
+                    </select>
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Save
+                <i class="lock icon"></i>
+            </button>
         </div>
     </div>
     `
-}
\ No newline at end of file
+}
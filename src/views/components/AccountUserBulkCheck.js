@@ -0,0 +1,171 @@
+export default {
+    name: 'AccountUserBulkCheck',
+    data() {
+        return {
+            loading: false,
+            phones: '',
+            csvFile: null,
+            report: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalUserBulkCheck').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        phoneList() {
+            return this.phones.split(/[\s,;]+/).map(phone => phone.trim()).filter(phone => phone !== '');
+        },
+        isValidForm() {
+            return this.phoneList().length > 0 || this.csvFile !== null;
+        },
+        handleFileChange(event) {
+            this.csvFile = event.target.files[0] || null;
+        },
+        statusColor(result) {
+            if (result.error) {
+                return 'red';
+            }
+            return result.is_on_whatsapp ? 'green' : 'grey';
+        },
+        statusText(result) {
+            if (result.error) {
+                return 'invalid';
+            }
+            return result.is_on_whatsapp ? 'on WhatsApp' : 'not on WhatsApp';
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            this.report = null;
+            try {
+                const formData = new FormData();
+                this.phoneList().forEach(phone => formData.append('phones', phone));
+                if (this.csvFile) {
+                    formData.append('file', this.csvFile);
+                }
+
+                let response = await window.http.post(`/user/check/bulk`, formData, {
+                    headers: {
+                        'Content-Type': 'multipart/form-data'
+                    }
+                })
+                this.report = response.data.results;
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.phones = '';
+            this.csvFile = null;
+            this.report = null;
+            const fileInput = document.querySelector('#bulkCheckUpload');
+            if (fileInput) {
+                fileInput.value = '';
+            }
+        },
+    },
+    template: `
+    <div class="olive card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui olive right ribbon label">Account</a>
+            <div class="header">Bulk Check Users</div>
+            <div class="description">
+                Check which numbers from a list or CSV are on WhatsApp
+            </div>
+        </div>
+    </div>
+
+    <!--  Modal User Bulk Check  -->
+    <div class="ui small modal" id="modalUserBulkCheck">
+        <i class="close icon"></i>
+        <div class="header">
+            Bulk Check Users
+        </div>
+        <div class="scrolling content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Phone Numbers</label>
+                    <textarea v-model="phones" rows="4"
+                              placeholder="One phone number per line, with country code"
+                              aria-label="Phone numbers"></textarea>
+                </div>
+
+                <div class="field">
+                    <label>CSV File</label>
+                    <input type="file" id="bulkCheckUpload" accept=".csv,text/csv" @change="handleFileChange">
+                    <small class="text">Phone numbers in the first column, with country code. A header row is allowed.</small>
+                </div>
+            </form>
+
+            <div v-if="report" style="margin-top: 1em">
+                <div class="ui four mini statistics">
+                    <div class="statistic">
+                        <div class="value">{{ report.total }}</div>
+                        <div class="label">Total</div>
+                    </div>
+                    <div class="green statistic">
+                        <div class="value">{{ report.on_whatsapp }}</div>
+                        <div class="label">On WhatsApp</div>
+                    </div>
+                    <div class="grey statistic">
+                        <div class="value">{{ report.not_on_whatsapp }}</div>
+                        <div class="label">Not on WhatsApp</div>
+                    </div>
+                    <div class="red statistic">
+                        <div class="value">{{ report.invalid }}</div>
+                        <div class="label">Invalid</div>
+                    </div>
+                </div>
+
+                <table class="ui celled compact table">
+                    <thead>
+                    <tr>
+                        <th>Phone</th>
+                        <th>Status</th>
+                        <th>Business</th>
+                        <th>Checked At</th>
+                    </tr>
+                    </thead>
+                    <tbody>
+                    <tr v-for="result in report.results" :key="result.phone">
+                        <td>{{ result.phone }}</td>
+                        <td><span class="ui mini label" :class="statusColor(result)">{{ statusText(result) }}</span></td>
+                        <td>{{ result.is_business ? (result.verified_name || 'Yes') : '' }}</td>
+                        <td>{{ result.checked_at }}<span v-if="result.cached"> (cached)</span></td>
+                    </tr>
+                    </tbody>
+                </table>
+            </div>
+        </div>
+        <div class="actions">
+            <button class="ui button" @click="handleReset" type="button">Reset</button>
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Check
+                <i class="search icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -0,0 +1,116 @@
+export default {
+    name: 'ChatCallLog',
+    data() {
+        return {
+            loading: false,
+            phone: '',
+            calls: [],
+        }
+    },
+    methods: {
+        async openModal() {
+            try {
+                await this.fetchCalls();
+                $('#modalChatCallLog').modal('show');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async handleFilter() {
+            try {
+                await this.fetchCalls();
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        getPhoneNumber(jid) {
+            return jid.split('@')[0];
+        },
+        getKind(call) {
+            const kind = call.is_video ? 'Video' : 'Voice';
+            return call.is_group ? `Group ${kind.toLowerCase()}` : kind;
+        },
+        formatDate(value) {
+            return value ? moment(value).format('MMM DD, YYYY HH:mm') : '-';
+        },
+        async fetchCalls() {
+            this.loading = true;
+            try {
+                const params = {limit: 100};
+                if (this.phone.trim() !== '') {
+                    params.phone = this.phone.trim();
+                }
+                let response = await window.http.get(`/calls`, {params})
+                this.calls = response.data.results.data || [];
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+    },
+    template: `
+    <div class="teal card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui teal right ribbon label">Chat</a>
+            <div class="header">Call Log</div>
+            <div class="description">
+                Incoming, missed and rejected calls
+            </div>
+        </div>
+    </div>
+
+    <!--  Modal Call Log  -->
+    <div class="ui large modal" id="modalChatCallLog">
+        <i class="close icon"></i>
+        <div class="header">
+            Call Log
+        </div>
+        <div class="scrolling content">
+            <form class="ui form" @submit.prevent="handleFilter">
+                <div class="field">
+                    <label>Caller</label>
+                    <div class="ui action input">
+                        <input v-model="phone" type="text" placeholder="Optional: 6289..."
+                               aria-label="Caller phone">
+                        <button class="ui teal button" type="submit"
+                                :class="{'loading': loading, 'disabled': loading}">
+                            <i class="filter icon"></i> Filter
+                        </button>
+                    </div>
+                </div>
+            </form>
+
+            <table class="ui celled compact table">
+                <thead>
+                <tr>
+                    <th>Caller</th>
+                    <th>Type</th>
+                    <th>Status</th>
+                    <th>Started</th>
+                    <th>Ended</th>
+                </tr>
+                </thead>
+                <tbody>
+                <tr v-if="calls.length === 0">
+                    <td colspan="5">No calls</td>
+                </tr>
+                <tr v-for="call in calls" :key="call.call_id">
+                    <td>{{ getPhoneNumber(call.from) }}</td>
+                    <td>{{ getKind(call) }}</td>
+                    <td>
+                        {{ call.status }}
+                        <span v-if="call.auto_rejected" class="ui mini label">auto</span>
+                    </td>
+                    <td>{{ formatDate(call.started_at) }}</td>
+                    <td>{{ formatDate(call.ended_at) }}</td>
+                </tr>
+                </tbody>
+            </table>
+        </div>
+    </div>
+    `
+}
@@ -560,6 +560,7 @@ export default {
                             </div>
                             <div class="meta">
                                 <span>{{ formatTimestamp(message.timestamp) }}</span>
+                                <span v-if="message.status">{{ message.status }}</span>
                                 <span v-if="message.id" class="right floated">
                                     ID: {{ message.id }}
                                 </span>
@@ -569,6 +570,12 @@ export default {
                                 <div v-if="message.media_type && message.url" class="media-container" style="margin-top: 0.5em;">
                                     <div v-if="getMediaDisplay(message)" v-html="getMediaDisplay(message).content"></div>
                                 </div>
+                                <div v-if="message.reactions" style="margin-top: 0.5em;">
+                                    <span v-for="reaction in message.reactions" :key="reaction.emoji"
+                                          class="ui basic mini label" :title="reaction.reactors.join(', ')">
+                                        {{ reaction.emoji }} {{ reaction.count }}
+                                    </span>
+                                </div>
                             </div>
                         </div>
                     </div>
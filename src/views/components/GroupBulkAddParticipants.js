@@ -0,0 +1,189 @@
+export default {
+    name: 'GroupBulkAddParticipants',
+    data() {
+        return {
+            loading: false,
+            groupId: '',
+            csvFile: null,
+            inviteMessage: '',
+            batchSize: 20,
+            batchDelay: 5,
+            report: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalGroupBulkAddParticipants').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.groupId.trim() !== '' && this.csvFile !== null;
+        },
+        handleFileChange(event) {
+            this.csvFile = event.target.files[0] || null;
+        },
+        statusColor(status) {
+            switch (status) {
+                case 'added':
+                    return 'green';
+                case 'invited':
+                    return 'blue';
+                default:
+                    return 'red';
+            }
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            this.report = null;
+            try {
+                const formData = new FormData();
+                formData.append('group_id', this.groupId);
+                formData.append('file', this.csvFile);
+                formData.append('invite_message', this.inviteMessage);
+                formData.append('batch_size', this.batchSize);
+                formData.append('batch_delay', this.batchDelay);
+
+                let response = await window.http.post(`/group/participants/bulk`, formData, {
+                    headers: {
+                        'Content-Type': 'multipart/form-data'
+                    }
+                })
+                this.report = response.data.results;
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.groupId = '';
+            this.csvFile = null;
+            this.inviteMessage = '';
+            this.batchSize = 20;
+            this.batchDelay = 5;
+            this.report = null;
+            const fileInput = document.querySelector('#bulkParticipantsUpload');
+            if (fileInput) {
+                fileInput.value = '';
+            }
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Group</a>
+            <div class="header">Bulk Add Participants</div>
+            <div class="description">
+                Add participants from a CSV and invite those who cannot be added
+            </div>
+        </div>
+    </div>
+
+    <!--  Modal Group Bulk Add Participants  -->
+    <div class="ui small modal" id="modalGroupBulkAddParticipants">
+        <i class="close icon"></i>
+        <div class="header">
+            Bulk Add Participants
+        </div>
+        <div class="scrolling content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Group ID</label>
+                    <input v-model="groupId" type="text"
+                           placeholder="120363024512399999@g.us"
+                           aria-label="Group ID">
+                </div>
+
+                <div class="field">
+                    <label>CSV File</label>
+                    <input type="file" id="bulkParticipantsUpload" accept=".csv,text/csv" @change="handleFileChange">
+                    <small class="text">Phone numbers in the first column, with country code. A header row is allowed.</small>
+                </div>
+
+                <div class="field">
+                    <label>Invite Message</label>
+                    <textarea v-model="inviteMessage" rows="2"
+                              placeholder="Sent with the invite to participants whose privacy settings prevent adding them"
+                              aria-label="Invite message"></textarea>
+                </div>
+
+                <div class="two fields">
+                    <div class="field">
+                        <label>Batch Size</label>
+                        <input v-model.number="batchSize" type="number" min="1" max="50" aria-label="Batch size">
+                    </div>
+                    <div class="field">
+                        <label>Delay Between Batches (seconds)</label>
+                        <input v-model.number="batchDelay" type="number" min="0" max="300" aria-label="Batch delay">
+                    </div>
+                </div>
+            </form>
+
+            <div v-if="report" style="margin-top: 1em">
+                <div class="ui four mini statistics">
+                    <div class="statistic">
+                        <div class="value">{{ report.total }}</div>
+                        <div class="label">Total</div>
+                    </div>
+                    <div class="green statistic">
+                        <div class="value">{{ report.added }}</div>
+                        <div class="label">Added</div>
+                    </div>
+                    <div class="blue statistic">
+                        <div class="value">{{ report.invited }}</div>
+                        <div class="label">Invited</div>
+                    </div>
+                    <div class="red statistic">
+                        <div class="value">{{ report.failed }}</div>
+                        <div class="label">Failed</div>
+                    </div>
+                </div>
+
+                <table class="ui celled compact table">
+                    <thead>
+                    <tr>
+                        <th>Participant</th>
+                        <th>Status</th>
+                        <th>Reason</th>
+                    </tr>
+                    </thead>
+                    <tbody>
+                    <tr v-for="result in report.results" :key="result.participant">
+                        <td>{{ result.participant }}</td>
+                        <td><span class="ui mini label" :class="statusColor(result.status)">{{ result.status }}</span></td>
+                        <td>{{ result.reason }}</td>
+                    </tr>
+                    </tbody>
+                </table>
+            </div>
+        </div>
+        <div class="actions">
+            <button class="ui button" @click="handleReset" type="button">Reset</button>
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Add Participants
+                <i class="users icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -53,12 +53,16 @@ export default {
         async getInviteLink() {
             this.loading = true;
             try {
-                const response = await window.http.get(`/group/invite-link`, {
-                    params: { 
-                        group_id: this.fullGroupID,
-                        reset: this.resetLink 
-                    }
-                });
+                // Resetting goes through the dedicated revoke flow so the old link stops working
+                const response = this.resetLink
+                    ? await window.http.post(`/group/invite-link/revoke`, {
+                        group_id: this.fullGroupID
+                    })
+                    : await window.http.get(`/group/invite-link`, {
+                        params: {
+                            group_id: this.fullGroupID
+                        }
+                    });
                 if (response.data.results && typeof response.data.results.invite_link === 'string') {
                     this.inviteLink = response.data.results.invite_link;
                 } else if (response.data && typeof response.data.invite_link === 'string') {
@@ -0,0 +1,103 @@
+export default {
+    name: 'GroupSetEphemeral',
+    data() {
+        return {
+            loading: false,
+            groupId: '',
+            timer: '7d',
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalGroupSetEphemeral').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.groupId.trim() !== '';
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalGroupSetEphemeral').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                let response = await window.http.post(`/group/ephemeral`, {
+                    group_id: this.groupId,
+                    timer: this.timer
+                })
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.groupId = '';
+            this.timer = '7d';
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Group</a>
+            <div class="header">Set Disappearing Messages</div>
+            <div class="description">
+                Set the group disappearing timer
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Group Set Ephemeral  -->
+    <div class="ui small modal" id="modalGroupSetEphemeral">
+        <i class="close icon"></i>
+        <div class="header">
+            Set Group Disappearing Messages
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Group ID</label>
+                    <input v-model="groupId" type="text"
+                           placeholder="120363024512399999@g.us"
+                           aria-label="Group ID">
+                </div>
+                
+                <div class="field">
+                    <label>Timer</label>
+                    <select v-model="timer" class="ui dropdown" aria-label="Timer">
+                        <option value="off">Off</option>
+                        <option value="24h">24 hours</option>
+                        <option value="7d">7 days</option>
+                        <option value="90d">90 days</option>
+                    </select>
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button" 
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Save
+                <i class="clock icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
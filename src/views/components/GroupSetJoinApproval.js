@@ -0,0 +1,101 @@
+export default {
+    name: 'GroupSetJoinApproval',
+    data() {
+        return {
+            loading: false,
+            groupId: '',
+            requireApproval: false,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalGroupSetJoinApproval').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.groupId.trim() !== '';
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalGroupSetJoinApproval').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                let response = await window.http.post(`/group/join-approval`, {
+                    group_id: this.groupId,
+                    require_approval: this.requireApproval
+                })
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.groupId = '';
+            this.requireApproval = false;
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Group</a>
+            <div class="header">Set Join Approval</div>
+            <div class="description">
+                Require admin approval for new members
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Group Set Join Approval  -->
+    <div class="ui small modal" id="modalGroupSetJoinApproval">
+        <i class="close icon"></i>
+        <div class="header">
+            Set Group Join Approval
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Group ID</label>
+                    <input v-model="groupId" type="text"
+                           placeholder="120363024512399999@g.us"
+                           aria-label="Group ID">
+                </div>
+                
+                <div class="field">
+                    <label>Join Approval</label>
+                    <div class="ui toggle checkbox">
+                        <input type="checkbox" v-model="requireApproval">
+                        <label>{{ requireApproval ? 'Admins approve new members' : 'Anyone with the link can join' }}</label>
+                    </div>
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button" 
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Save
+                <i class="user check icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
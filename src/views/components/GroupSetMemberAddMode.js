@@ -0,0 +1,109 @@
+export default {
+    name: 'GroupSetMemberAddMode',
+    data() {
+        return {
+            loading: false,
+            groupId: '',
+            mode: 'admin_add',
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalGroupSetMemberAddMode').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.groupId.trim() !== '';
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalGroupSetMemberAddMode').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                let response = await window.http.post(`/group/member-add-mode`, {
+                    group_id: this.groupId,
+                    mode: this.mode
+                })
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.groupId = '';
+            this.mode = 'admin_add';
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Group</a>
+            <div class="header">Set Member Add Mode</div>
+            <div class="description">
+                Choose who can add participants
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Group Set Member Add Mode  -->
+    <div class="ui small modal" id="modalGroupSetMemberAddMode">
+        <i class="close icon"></i>
+        <div class="header">
+            Set Group Member Add Mode
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Group ID</label>
+                    <input v-model="groupId" type="text"
+                           placeholder="120363024512399999@g.us"
+                           aria-label="Group ID">
+                </div>
+                
+                <div class="grouped fields">
+                    <label>Who can add participants</label>
+                    <div class="field">
+                        <div class="ui radio checkbox">
+                            <input type="radio" value="admin_add" v-model="mode" id="memberAddModeAdmin">
+                            <label for="memberAddModeAdmin">Only admins</label>
+                        </div>
+                    </div>
+                    <div class="field">
+                        <div class="ui radio checkbox">
+                            <input type="radio" value="all_member_add" v-model="mode" id="memberAddModeAll">
+                            <label for="memberAddModeAll">All members</label>
+                        </div>
+                    </div>
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button" 
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Save
+                <i class="user plus icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -32,7 +32,7 @@ export default {
                 return false;
             }
 
-            if (!this.message_id.trim() || !this.emoji.trim()) {
+            if (!this.message_id.trim()) {
                 return false;
             }
 
@@ -102,7 +102,7 @@ export default {
                 </div>
                 <div class="field">
                     <label>Emoji</label>
-                    <input v-model="emoji" type="text" placeholder="Please enter emoji"
+                    <input v-model="emoji" type="text" placeholder="Please enter emoji, leave empty to remove the reaction"
                            aria-label="message id">
                 </div>
             </form>
@@ -81,7 +81,7 @@ export default {
             <a class="ui red right ribbon label">Message</a>
             <div class="header">Update Message</div>
             <div class="description">
-                Update your sent message
+                Update your sent message or media caption
             </div>
         </div>
     </div>
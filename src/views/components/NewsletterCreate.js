@@ -0,0 +1,135 @@
+export default {
+    name: 'NewsletterCreate',
+    data() {
+        return {
+            loading: false,
+            name: '',
+            description: '',
+            pictureFile: null,
+            previewUrl: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalNewsletterCreate').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.name.trim() !== '';
+        },
+        handleFileChange(event) {
+            const file = event.target.files[0];
+            if (file) {
+                this.pictureFile = file;
+                const reader = new FileReader();
+                reader.onload = (e) => {
+                    this.previewUrl = e.target.result;
+                };
+                reader.readAsDataURL(file);
+            }
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalNewsletterCreate').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                const formData = new FormData();
+                formData.append('name', this.name);
+                formData.append('description', this.description);
+                if (this.pictureFile) {
+                    formData.append('picture', this.pictureFile);
+                }
+
+                let response = await window.http.post(`/newsletter`, formData, {
+                    headers: {
+                        'Content-Type': 'multipart/form-data'
+                    }
+                })
+                this.handleReset();
+                const link = response.data.results.invite_link;
+                return link ? `${response.data.message}: ${link}` : response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.name = '';
+            this.description = '';
+            this.pictureFile = null;
+            this.previewUrl = null;
+            const fileInput = document.querySelector('#newsletterCreatePicture');
+            if (fileInput) {
+                fileInput.value = '';
+            }
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Newsletter</a>
+            <div class="header">Create Newsletter</div>
+            <div class="description">
+                Start a new broadcast channel
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Newsletter Create  -->
+    <div class="ui small modal" id="modalNewsletterCreate">
+        <i class="close icon"></i>
+        <div class="header">
+            Create Newsletter
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Name</label>
+                    <input v-model="name" type="text" maxlength="100"
+                           placeholder="My Store Updates"
+                           aria-label="Newsletter name">
+                </div>
+                <div class="field">
+                    <label>Description</label>
+                    <textarea v-model="description" rows="3" maxlength="2048"
+                              placeholder="What followers can expect"
+                              aria-label="Newsletter description"></textarea>
+                </div>
+                <div class="field">
+                    <label>Picture</label>
+                    <input type="file" id="newsletterCreatePicture" accept="image/*" @change="handleFileChange">
+                    <small class="text">Optional, JPEG or PNG.</small>
+                </div>
+                <div class="field" v-if="previewUrl">
+                    <img :src="previewUrl" alt="Preview" style="width: 100px; height: 100px; object-fit: cover; border-radius: 8px;">
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Create
+                <i class="plus icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
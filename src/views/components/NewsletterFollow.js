@@ -0,0 +1,117 @@
+export default {
+    name: 'NewsletterFollow',
+    data() {
+        return {
+            loading: false,
+            link: '',
+            info: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalNewsletterFollow').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.link.trim() !== '';
+        },
+        async handlePreview() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            this.loading = true;
+            try {
+                let response = await window.http.get(`/newsletter/info`, {
+                    params: {link: this.link}
+                })
+                this.info = response.data.results.newsletter;
+            } catch (error) {
+                this.info = null;
+                showErrorInfo(error.response ? error.response.data.message : error.message)
+            } finally {
+                this.loading = false;
+            }
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalNewsletterFollow').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                let response = await window.http.post(`/newsletter/follow`, {link: this.link})
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.link = '';
+            this.info = null;
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Newsletter</a>
+            <div class="header">Follow Newsletter</div>
+            <div class="description">
+                Preview and follow a channel by invite link
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Newsletter Follow  -->
+    <div class="ui small modal" id="modalNewsletterFollow">
+        <i class="close icon"></i>
+        <div class="header">
+            Follow Newsletter
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Invite Link</label>
+                    <div class="ui action input">
+                        <input v-model="link" type="text"
+                               placeholder="https://whatsapp.com/channel/0029Va..."
+                               aria-label="Invite link">
+                        <button class="ui button" :class="{'loading': loading}" @click.prevent="handlePreview" type="button">
+                            Preview
+                        </button>
+                    </div>
+                </div>
+            </form>
+            <div class="ui segment" v-if="info">
+                <div class="ui header">{{ info.thread_metadata?.name?.text || 'N/A' }}</div>
+                <p>{{ info.thread_metadata?.description?.text }}</p>
+                <p><b>Followers:</b> {{ info.thread_metadata?.subscribers_count || 0 }}</p>
+            </div>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Follow
+                <i class="bell icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -54,6 +54,18 @@ export default {
 
             }
         },
+        async handleToggleMute(newsletter) {
+            try {
+                const muted = newsletter.viewer_metadata?.mute === 'on';
+                await window.http.post(`/newsletter/${encodeURIComponent(newsletter.id)}/${muted ? 'unmute' : 'mute'}`)
+                this.dtClear()
+                await this.submitApi();
+                this.dtRebuild()
+                showSuccessInfo(muted ? "Success unmute newsletter" : "Success mute newsletter")
+            } catch (err) {
+                showErrorInfo(err.response ? err.response.data.message : err.message)
+            }
+        },
         async submitApi() {
             try {
                 let response = await window.http.get(`/user/my/newsletters`)
@@ -106,6 +118,9 @@ export default {
                     <td>{{ n.viewer_metadata?.role || 'N/A' }}</td>
                     <td>{{ formatDate(n.thread_metadata?.creation_time) }}</td>
                     <td>
+                        <button class="ui tiny button" @click="handleToggleMute(n)">
+                            {{ n.viewer_metadata?.mute === 'on' ? 'Unmute' : 'Mute' }}
+                        </button>
                         <button class="ui red tiny button" @click="handleUnfollowNewsletter(n.id)">Unfollow</button>
                     </td>
                 </tr>
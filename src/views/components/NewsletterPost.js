@@ -0,0 +1,119 @@
+export default {
+    name: 'NewsletterPost',
+    data() {
+        return {
+            loading: false,
+            newsletterId: '',
+            message: '',
+            mediaFile: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalNewsletterPost').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.newsletterId.trim() !== '' && (this.message.trim() !== '' || this.mediaFile !== null);
+        },
+        handleFileChange(event) {
+            this.mediaFile = event.target.files[0] || null;
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalNewsletterPost').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                const formData = new FormData();
+                formData.append('message', this.message);
+                if (this.mediaFile) {
+                    const field = this.mediaFile.type.startsWith('video/') ? 'video' : 'image';
+                    formData.append(field, this.mediaFile);
+                }
+
+                let response = await window.http.post(`/newsletter/${encodeURIComponent(this.newsletterId)}/post`, formData, {
+                    headers: {
+                        'Content-Type': 'multipart/form-data'
+                    }
+                })
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.message = '';
+            this.mediaFile = null;
+            const fileInput = document.querySelector('#newsletterPostMedia');
+            if (fileInput) {
+                fileInput.value = '';
+            }
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Newsletter</a>
+            <div class="header">Post Update</div>
+            <div class="description">
+                Publish text, image or video to your channel
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Newsletter Post  -->
+    <div class="ui small modal" id="modalNewsletterPost">
+        <i class="close icon"></i>
+        <div class="header">
+            Post to Newsletter
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Newsletter ID</label>
+                    <input v-model="newsletterId" type="text"
+                           placeholder="120363144038483540@newsletter"
+                           aria-label="Newsletter ID">
+                </div>
+                <div class="field">
+                    <label>Message</label>
+                    <textarea v-model="message" rows="4"
+                              placeholder="Text of the update, used as caption when media is attached"
+                              aria-label="Message"></textarea>
+                </div>
+                <div class="field">
+                    <label>Image or Video</label>
+                    <input type="file" id="newsletterPostMedia" accept="image/*,video/*" @change="handleFileChange">
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Post
+                <i class="send icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -0,0 +1,133 @@
+export default {
+    name: 'NewsletterUpdate',
+    data() {
+        return {
+            loading: false,
+            newsletterId: '',
+            name: '',
+            description: '',
+            pictureFile: null,
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalNewsletterUpdate').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.newsletterId.trim() !== '' &&
+                (this.name.trim() !== '' || this.description.trim() !== '' || this.pictureFile !== null);
+        },
+        handleFileChange(event) {
+            this.pictureFile = event.target.files[0] || null;
+        },
+        async handleSubmit() {
+            if (!this.isValidForm() || this.loading) {
+                return;
+            }
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalNewsletterUpdate').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            this.loading = true;
+            try {
+                const formData = new FormData();
+                if (this.name.trim() !== '') {
+                    formData.append('name', this.name);
+                }
+                if (this.description.trim() !== '') {
+                    formData.append('description', this.description);
+                }
+                if (this.pictureFile) {
+                    formData.append('picture', this.pictureFile);
+                }
+
+                let response = await window.http.post(`/newsletter/${encodeURIComponent(this.newsletterId)}/update`, formData, {
+                    headers: {
+                        'Content-Type': 'multipart/form-data'
+                    }
+                })
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.newsletterId = '';
+            this.name = '';
+            this.description = '';
+            this.pictureFile = null;
+            const fileInput = document.querySelector('#newsletterUpdatePicture');
+            if (fileInput) {
+                fileInput.value = '';
+            }
+        },
+    },
+    template: `
+    <div class="green card" @click="openModal" style="cursor: pointer">
+        <div class="content">
+            <a class="ui green right ribbon label">Newsletter</a>
+            <div class="header">Update Newsletter</div>
+            <div class="description">
+                Change name, description or picture
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal Newsletter Update  -->
+    <div class="ui small modal" id="modalNewsletterUpdate">
+        <i class="close icon"></i>
+        <div class="header">
+            Update Newsletter
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Newsletter ID</label>
+                    <input v-model="newsletterId" type="text"
+                           placeholder="120363144038483540@newsletter"
+                           aria-label="Newsletter ID">
+                </div>
+                <div class="field">
+                    <label>New Name</label>
+                    <input v-model="name" type="text" maxlength="100"
+                           placeholder="Leave empty to keep the current name"
+                           aria-label="Newsletter name">
+                </div>
+                <div class="field">
+                    <label>New Description</label>
+                    <textarea v-model="description" rows="3" maxlength="2048"
+                              placeholder="Leave empty to keep the current description"
+                              aria-label="Newsletter description"></textarea>
+                </div>
+                <div class="field">
+                    <label>New Picture</label>
+                    <input type="file" id="newsletterUpdatePicture" accept="image/*" @change="handleFileChange">
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button"
+                    :class="{'loading': this.loading, 'disabled': !this.isValidForm() || this.loading}"
+                    @click.prevent="handleSubmit" type="button">
+                Update
+                <i class="save icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -12,6 +12,7 @@ export default {
             loading: false,
             selectedFileName: null,
             is_forwarded: false,
+            simulate_typing: false,
             audio_url: null,
             duration: 0,
         }
@@ -59,6 +60,9 @@ export default {
                 let payload = new FormData();
                 payload.append("phone", this.phone_id)
                 payload.append("is_forwarded", this.is_forwarded)
+                if (this.simulate_typing) {
+                    payload.append("simulate_typing", true)
+                }
                 if (this.duration && this.duration > 0) {
                     payload.append("duration", this.duration)
                 }
@@ -88,6 +92,7 @@ export default {
             this.phone = '';
             this.type = window.TYPEUSER;
             this.is_forwarded = false;
+            this.simulate_typing = false;
             this.duration = 0;
             $("#file_audio").val('');
             this.selectedFileName = null;
@@ -127,6 +132,13 @@ export default {
                         <label>Mark audio as forwarded</label>
                     </div>
                 </div>
+                <div class="field">
+                    <label>Simulate Typing</label>
+                    <div class="ui toggle checkbox">
+                        <input type="checkbox" aria-label="simulate typing" v-model="simulate_typing">
+                        <label>Mark the chat read and show recording before sending</label>
+                    </div>
+                </div>
                 <div class="field">
                     <label>Disappearing Duration (seconds)</label>
                     <input v-model.number="duration" type="number" min="0" placeholder="0 (no expiry)" aria-label="duration"/>
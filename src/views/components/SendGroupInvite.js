@@ -0,0 +1,120 @@
+export default {
+    name: 'SendGroupInvite',
+    data() {
+        return {
+            phone: '',
+            group_id: '',
+            caption: '',
+            expiration_days: null,
+            loading: false,
+        }
+    },
+    computed: {
+        phone_id() {
+            return this.phone + window.TYPEUSER;
+        }
+    },
+    methods: {
+        openModal() {
+            $('#modalSendGroupInvite').modal({
+                onApprove: function () {
+                    return false;
+                }
+            }).modal('show');
+        },
+        isValidForm() {
+            return this.phone.trim() !== '' && this.group_id.trim() !== '';
+        },
+        async handleSubmit() {
+            try {
+                let response = await this.submitApi()
+                showSuccessInfo(response)
+                $('#modalSendGroupInvite').modal('hide');
+            } catch (err) {
+                showErrorInfo(err)
+            }
+        },
+        async submitApi() {
+            if (!this.isValidForm()) {
+                return;
+            }
+
+            this.loading = true;
+            try {
+                const payload = {
+                    phone: this.phone_id,
+                    group_id: this.group_id,
+                    caption: this.caption,
+                    ...(this.expiration_days && this.expiration_days > 0 ? {expiration: this.expiration_days * 24 * 60 * 60} : {})
+                }
+                let response = await window.http.post(`/send/group-invite`, payload)
+                this.handleReset();
+                return response.data.message;
+            } catch (error) {
+                if (error.response) {
+                    throw new Error(error.response.data.message);
+                }
+                throw new Error(error.message);
+            } finally {
+                this.loading = false;
+            }
+        },
+        handleReset() {
+            this.phone = '';
+            this.group_id = '';
+            this.caption = '';
+            this.expiration_days = null;
+        },
+    },
+    template: `
+    <div class="blue card" @click="openModal()" style="cursor: pointer">
+        <div class="content">
+            <a class="ui blue right ribbon label">Send</a>
+            <div class="header">Send Group Invite</div>
+            <div class="description">
+                Send a join group invite card to a user
+            </div>
+        </div>
+    </div>
+    
+    <!--  Modal SendGroupInvite  -->
+    <div class="ui small modal" id="modalSendGroupInvite">
+        <i class="close icon"></i>
+        <div class="header">
+            Send Group Invite
+        </div>
+        <div class="content">
+            <form class="ui form">
+                <div class="field">
+                    <label>Phone</label>
+                    <input v-model="phone" type="text" placeholder="6289685028129"
+                           aria-label="phone">
+                    <input :value="phone_id" disabled aria-label="whatsapp_id">
+                </div>
+                <div class="field">
+                    <label>Group ID</label>
+                    <input v-model="group_id" type="text" placeholder="120363024512399999@g.us"
+                           aria-label="group id">
+                </div>
+                <div class="field">
+                    <label>Caption</label>
+                    <textarea v-model="caption" rows="2" placeholder="Optional message shown with the invite"
+                              aria-label="caption"></textarea>
+                </div>
+                <div class="field">
+                    <label>Expires In (days)</label>
+                    <input v-model.number="expiration_days" type="number" min="1" max="30" placeholder="Optional, valid until the link is revoked"
+                           aria-label="expiration days"/>
+                </div>
+            </form>
+        </div>
+        <div class="actions">
+            <button class="ui approve positive right labeled icon button" :class="{'loading': this.loading, 'disabled': !isValidForm() || loading}"
+                 @click.prevent="handleSubmit">
+                Send
+                <i class="send icon"></i>
+            </button>
+        </div>
+    </div>
+    `
+}
@@ -12,6 +12,9 @@ export default {
             text: '',
             reply_message_id: '',
             is_forwarded: false,
+            simulate_typing: false,
+            disable_link_preview: false,
+            is_markdown: false,
             duration: 0,
             loading: false,
         }
@@ -60,8 +63,15 @@ export default {
                 const payload = {
                     phone: this.phone_id,
                     message: this.text.trim(),
-                    is_forwarded: this.is_forwarded
+                    is_forwarded: this.is_forwarded,
+                    disable_link_preview: this.disable_link_preview
                 };
+                if (this.simulate_typing) {
+                    payload.simulate_typing = true;
+                }
+                if (this.is_markdown) {
+                    payload.format = 'markdown';
+                }
                 if (this.reply_message_id !== '') {
                     payload.reply_message_id = this.reply_message_id;
                 }
@@ -87,6 +97,9 @@ export default {
             this.text = '';
             this.reply_message_id = '';
             this.is_forwarded = false;
+            this.simulate_typing = false;
+            this.disable_link_preview = false;
+            this.is_markdown = false;
             this.duration = 0;
         },
     },
@@ -128,6 +141,27 @@ export default {
                         <label>Mark message as forwarded</label>
                     </div>
                 </div>
+                <div class="field">
+                    <label>Markdown</label>
+                    <div class="ui toggle checkbox">
+                        <input type="checkbox" aria-label="markdown" v-model="is_markdown">
+                        <label>Convert **bold**, _italic_, lists and [links](url) to WhatsApp formatting</label>
+                    </div>
+                </div>
+                <div class="field">
+                    <label>Link Preview</label>
+                    <div class="ui toggle checkbox">
+                        <input type="checkbox" aria-label="disable link preview" v-model="disable_link_preview">
+                        <label>Send links without a rich preview</label>
+                    </div>
+                </div>
+                <div class="field">
+                    <label>Simulate Typing</label>
+                    <div class="ui toggle checkbox">
+                        <input type="checkbox" aria-label="simulate typing" v-model="simulate_typing">
+                        <label>Mark the chat read and show typing before sending</label>
+                    </div>
+                </div>
                 <div class="field">
                     <label>Disappearing Duration (seconds)</label>
                     <input v-model.number="duration" type="number" min="0" placeholder="0 (no expiry)" aria-label="duration"/>
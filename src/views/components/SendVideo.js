@@ -22,6 +22,7 @@ export default {
             video_url: null,
             selectedFileName: null,
             is_forwarded: false,
+            simulate_typing: false,
             duration: 0
         }
     },
@@ -35,6 +36,7 @@ export default {
             // If view_once is set to true, set is_forwarded to false
             if (newValue === true) {
                 this.is_forwarded = false;
+            this.simulate_typing = false;
                 this.duration = 0;
             }
         }
@@ -95,6 +97,9 @@ export default {
                 payload.append("view_once", this.view_once)
                 payload.append("compress", this.compress)
                 payload.append("is_forwarded", this.is_forwarded)
+                if (this.simulate_typing) {
+                    payload.append("simulate_typing", true)
+                }
                 if (this.duration && this.duration > 0) {
                     payload.append("duration", this.duration)
                 }
@@ -187,6 +192,13 @@ export default {
                         <label>Mark video as forwarded</label>
                     </div>
                 </div>
+                <div class="field">
+                    <label>Simulate Typing</label>
+                    <div class="ui toggle checkbox">
+                        <input type="checkbox" aria-label="simulate typing" v-model="simulate_typing">
+                        <label>Mark the chat read and show typing before sending</label>
+                    </div>
+                </div>
                 <div class="field">
                     <label>Disappearing Duration (seconds)</label>
                     <input v-model.number="duration" type="number" min="0" placeholder="0 (no expiry)" aria-label="duration"/>